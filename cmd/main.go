@@ -32,12 +32,12 @@ func main() {
 	// start new map for domains record
 	dataMap := data.New()
 
-	// starting the application core
-	core := app.New(dataMap, cnf)
-
 	// new dns server
 	dnsServer := dns.New(dataMap, cnf)
 
+	// starting the application core
+	core := app.New(dataMap, dnsServer, cnf)
+
 	// start dns server
 	dnsServer.Run()
 
@@ -72,13 +72,53 @@ func main() {
 	api.AddAddDNSEntryHandler = apiAdd.AddDNSEntryHandlerFunc(core.AddDNSEntryHandler)
 	api.DeleteDeleteDNSEntryHandler = apiDelete.DeleteDNSEntryHandlerFunc(core.DeleteDNSEntryHandler)
 	api.ShowListOneDNSEntryHandler = apiShow.ListOneDNSEntryHandlerFunc(core.ListOneDNSEntryHandler)
+	api.ShowPreviewDNSEntryHandler = apiShow.PreviewDNSEntryHandlerFunc(core.PreviewDNSEntryHandler)
+	api.ListShowHealthHandler = apiList.ShowHealthHandlerFunc(core.ShowHealthHandler)
+	api.ShowListOneHealthHandler = apiShow.ListOneHealthHandlerFunc(core.ListOneHealthHandler)
 	api.ListShowDNSRecordsHandler = apiList.ShowDNSRecordsHandlerFunc(core.ShowDNSRecordsHandler)
 	api.UpdateUpdateDNSEntryHandler = apiUpdate.UpdateDNSEntryHandlerFunc(core.UpdateDNSEntryHandler)
+	api.ListShowDkimKeysHandler = apiList.ShowDkimKeysHandlerFunc(core.ShowDkimKeysHandler)
+	api.AddAddDkimKeyHandler = apiAdd.AddDkimKeyHandlerFunc(core.AddDkimKeyHandler)
+	api.UpdateRotateDkimKeyHandler = apiUpdate.RotateDkimKeyHandlerFunc(core.RotateDkimKeyHandler)
+	api.DeleteRevokeDkimKeyHandler = apiDelete.RevokeDkimKeyHandlerFunc(core.RevokeDkimKeyHandler)
+	api.ShowExportDkimKeyHandler = apiShow.ExportDkimKeyHandlerFunc(core.ExportDkimKeyHandler)
+	api.ShowShowMailPolicyHandler = apiShow.ShowMailPolicyHandlerFunc(core.ShowMailPolicyHandler)
+	api.UpdateUpdateMailPolicyHandler = apiUpdate.UpdateMailPolicyHandlerFunc(core.UpdateMailPolicyHandler)
+	api.AddAddACLHandler = apiAdd.AddACLHandlerFunc(core.AddACLHandler)
+	api.DeleteDeleteACLHandler = apiDelete.DeleteACLHandlerFunc(core.DeleteACLHandler)
+	api.ShowListOneACLHandler = apiShow.ListOneACLHandlerFunc(core.ListOneACLHandler)
+	api.ListShowAclsHandler = apiList.ShowAclsHandlerFunc(core.ShowAclsHandler)
+	api.AddAddBlocklistHandler = apiAdd.AddBlocklistHandlerFunc(core.AddBlocklistHandler)
+	api.DeleteDeleteBlocklistHandler = apiDelete.DeleteBlocklistHandlerFunc(core.DeleteBlocklistHandler)
+	api.ShowListOneBlocklistHandler = apiShow.ListOneBlocklistHandlerFunc(core.ListOneBlocklistHandler)
+	api.ListShowBlocklistsHandler = apiList.ShowBlocklistsHandlerFunc(core.ShowBlocklistsHandler)
+	api.AddAddOverrideHandler = apiAdd.AddOverrideHandlerFunc(core.AddOverrideHandler)
+	api.DeleteDeleteOverrideHandler = apiDelete.DeleteOverrideHandlerFunc(core.DeleteOverrideHandler)
+	api.ShowListOneOverrideHandler = apiShow.ListOneOverrideHandlerFunc(core.ListOneOverrideHandler)
+	api.ListShowOverridesHandler = apiList.ShowOverridesHandlerFunc(core.ShowOverridesHandler)
+	api.ShowShowRrlHandler = apiShow.ShowRrlHandlerFunc(core.ShowRrlHandler)
+	api.UpdateUpdateRrlHandler = apiUpdate.UpdateRrlHandlerFunc(core.UpdateRrlHandler)
+	api.AddAddViewHandler = apiAdd.AddViewHandlerFunc(core.AddViewHandler)
+	api.DeleteDeleteViewHandler = apiDelete.DeleteViewHandlerFunc(core.DeleteViewHandler)
+	api.ShowListOneViewHandler = apiShow.ListOneViewHandlerFunc(core.ListOneViewHandler)
+	api.ListShowViewsHandler = apiList.ShowViewsHandlerFunc(core.ShowViewsHandler)
+	api.AddAddViewDNSEntryHandler = apiAdd.AddViewDNSEntryHandlerFunc(core.AddViewDNSEntryHandler)
+	api.DeleteDeleteViewDNSEntryHandler = apiDelete.DeleteViewDNSEntryHandlerFunc(core.DeleteViewDNSEntryHandler)
+	api.ShowListOneViewDNSEntryHandler = apiShow.ListOneViewDNSEntryHandlerFunc(core.ListOneViewDNSEntryHandler)
+	api.ListShowViewDNSRecordsHandler = apiList.ShowViewDNSRecordsHandlerFunc(core.ShowViewDNSRecordsHandler)
+	api.UpdateUpdateViewDNSEntryHandler = apiUpdate.UpdateViewDNSEntryHandlerFunc(core.UpdateViewDNSEntryHandler)
 
 	server := restapi.NewServer(api)
 
 	server.ConfigureAPI()
 
+	// metrics and dns over https next to rest api, policy files of MTA-STS are only served over tls
+	handler := server.GetHandler()
+	if cnf.DohOnAPI {
+		handler = dnsServer.DoH(handler)
+	}
+	server.SetHandler(dnsServer.Metrics(handler))
+
 	var port int
 	if port, err = strconv.Atoi(cnf.HTTPPort); err != nil {
 		log.Fatalf("%v\n", err)
@@ -86,7 +126,7 @@ func main() {
 
 	server.GracefulTimeout = 3 * time.Second
 	server.Port = port
-	server.Host = "127.0.0.1"
+	server.Host = cnf.HTTPHost
 
 	if err = server.Serve(); err != nil {
 		log.Fatalf("start rest api server %v\n", err)
@@ -0,0 +1,19 @@
+package app
+
+import (
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	apiAdd "github.com/MarlikAlmighty/mdns/internal/gen/restapi/operations/add"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+func (core *Core) AddACLHandler(params apiAdd.AddACLParams) middleware.Responder {
+
+	if err := core.Server.SetACL(params.Add); err != nil {
+		return apiAdd.NewAddACLBadRequest().WithPayload(&models.Answer{
+			Code:    400,
+			Message: err.Error(),
+		})
+	}
+
+	return apiAdd.NewAddACLOK().WithPayload(core.Server.GetACL(params.Add.Name))
+}
@@ -0,0 +1,19 @@
+package app
+
+import (
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	apiAdd "github.com/MarlikAlmighty/mdns/internal/gen/restapi/operations/add"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+func (core *Core) AddBlocklistHandler(params apiAdd.AddBlocklistParams) middleware.Responder {
+
+	if err := core.Server.SetBlocklist(params.Add); err != nil {
+		return apiAdd.NewAddBlocklistBadRequest().WithPayload(&models.Answer{
+			Code:    400,
+			Message: err.Error(),
+		})
+	}
+
+	return apiAdd.NewAddBlocklistOK().WithPayload(core.Server.GetBlocklist(params.Add.Name))
+}
@@ -0,0 +1,21 @@
+package app
+
+import (
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	apiAdd "github.com/MarlikAlmighty/mdns/internal/gen/restapi/operations/add"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+func (core *Core) AddDkimKeyHandler(params apiAdd.AddDkimKeyParams) middleware.Responder {
+
+	md, err := core.Server.AddDKIMKey(swag.StringValue(params.View), params.Domain, params.Add)
+	if err != nil {
+		return apiAdd.NewAddDkimKeyBadRequest().WithPayload(&models.Answer{
+			Code:    400,
+			Message: err.Error(),
+		})
+	}
+
+	return apiAdd.NewAddDkimKeyOK().WithPayload(md)
+}
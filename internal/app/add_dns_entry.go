@@ -1,61 +1,133 @@
 package app
 
 import (
-	"crypto/rsa"
+	"errors"
+	mdns "github.com/MarlikAlmighty/mdns/internal/dns"
 	"github.com/MarlikAlmighty/mdns/internal/gen/models"
 	apiAdd "github.com/MarlikAlmighty/mdns/internal/gen/restapi/operations/add"
 	"github.com/go-openapi/runtime/middleware"
+	"time"
 )
 
 func (core *Core) AddDNSEntryHandler(params apiAdd.AddDNSEntryParams) middleware.Responder {
+	md, err := core.addDNSEntry(core.Resolver, params.Add)
+	if err != nil {
+		return apiAdd.NewAddDNSEntryBadRequest().WithPayload(&models.Answer{
+			Code:    400,
+			Message: err.Error(),
+		})
+	}
+	return apiAdd.NewAddDNSEntryOK().WithPayload(md)
+}
 
-	md := core.Resolver.Get(params.Add.Domain)
-
-	var (
-		ipv6 string
-		err  error
-	)
-
-	md.Ipv6s = []string{}
-	for _, v := range params.Add.Ipv4s {
-		ipv6, err = core.IPV4ToIPV6(v)
-		if err != nil {
-			return apiAdd.NewAddDNSEntryBadRequest().WithPayload(&models.Answer{
-				Code:    400,
-				Message: "can't convert ipv4 to ipv6",
-			})
-		}
-		md.Ipv6s = append(md.Ipv6s, ipv6)
+// addDNSEntry add entry to zones, new entries get a dkim key
+func (core *Core) addDNSEntry(r Resolver, add *models.DNSEntry) (*models.DNSEntry, error) {
+
+	// zones are stored and looked up under lowercase fully qualified names
+	add.Domain = mdns.DomainKey(add.Domain)
+
+	if err := core.CheckSubnets(add.Subnets); err != nil {
+		return nil, err
 	}
 
-	var (
-		privRSA *rsa.PrivateKey
-		pubRSA  *rsa.PublicKey
-	)
+	if err := core.CheckGeo(add.Geo); err != nil {
+		return nil, err
+	}
 
-	if privRSA, pubRSA, err = core.GenerateRsaKeyPair(); err != nil {
-		return apiAdd.NewAddDNSEntryBadRequest().WithPayload(&models.Answer{
-			Code:    400,
-			Message: "can't generate rsa pair",
-		})
+	if err := core.CheckHealth(add.HealthCheck); err != nil {
+		return nil, err
 	}
 
-	var pubStr string
-	if pubStr, err = core.ExportRsaPublicKeyAsStr(pubRSA); err != nil {
-		return apiAdd.NewAddDNSEntryBadRequest().WithPayload(&models.Answer{
-			Code:    400,
-			Message: "can't convert public cert to string",
-		})
+	if err := core.CheckBalance(add.Balance); err != nil {
+		return nil, err
 	}
 
-	if md.Domain == "" || len(md.Ipv4s) == 0 {
-		md.Domain = params.Add.Domain
-		md.Ipv4s = params.Add.Ipv4s
+	if err := core.CheckPtrs(add.Domain, add.Ptrs); err != nil {
+		return nil, err
 	}
 
-	md.DkimPrivateKey = core.ExportRsaPrivateKeyAsStr(privRSA)
-	md.DkimPublicKey = pubStr
-	md.Acme = []string{""}
-	core.Resolver.Set(md.Domain, md)
-	return apiAdd.NewAddDNSEntryOK().WithPayload(md)
+	if err := core.CheckAddresses(add.Ipv4s, add.Ipv6s); err != nil {
+		return nil, err
+	}
+
+	if err := core.CheckSynthesis(add.IPV6Synthesis, add.Nat64Prefix); err != nil {
+		return nil, err
+	}
+
+	if err := core.CheckNameServers(add.Domain, add.NameServers); err != nil {
+		return nil, err
+	}
+
+	if err := core.CheckDelegations(add.Domain, add.Delegations); err != nil {
+		return nil, err
+	}
+
+	if err := core.CheckSrvs(add.Domain, add.Srvs); err != nil {
+		return nil, err
+	}
+
+	if err := core.CheckTxts(add.Domain, add.Txts); err != nil {
+		return nil, err
+	}
+
+	if err := core.CheckMail(add.Domain, add.Mail); err != nil {
+		return nil, err
+	}
+
+	// zone gets its first dkim key once, re-adding keeps the keys; the key is generated before
+	// the entry is locked for the change
+	var key *models.DkimKey
+	if len(r.Get(add.Domain).DkimKeys) == 0 {
+		var err error
+		if key, err = mdns.NewDKIMKey(mdns.DkimSelector, ""); err != nil {
+			return nil, errors.New("can't generate dkim key")
+		}
+	}
+
+	var out models.DNSEntry
+	err := r.Update(add.Domain, func(md *models.DNSEntry) error {
+
+		if md.Domain == "" || len(md.Ipv4s) == 0 {
+			md.Domain = add.Domain
+			md.Ipv4s = add.Ipv4s
+		}
+
+		if len(md.DkimKeys) == 0 && key != nil {
+			addDkimKey(md, key)
+		}
+
+		md.Acme = []string{""}
+		md.Ipv6s = add.Ipv6s
+		md.IPV6Synthesis = add.IPV6Synthesis
+		md.Nat64Prefix = add.Nat64Prefix
+		md.NameServers = add.NameServers
+		md.Delegations = add.Delegations
+		md.Srvs = add.Srvs
+		md.Txts = add.Txts
+		// mail policy is kept when it is left out, it has its own endpoint
+		if add.Mail != nil {
+			md.Mail = add.Mail
+		}
+		md.Subnets = add.Subnets
+		md.Geo = add.Geo
+		md.HealthCheck = add.HealthCheck
+		md.Balance = add.Balance
+		md.AutoPtr = add.AutoPtr
+		md.Ptrs = add.Ptrs
+		out = *md
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// addDkimKey key of selector mail which signs at once
+func addDkimKey(md *models.DNSEntry, key *models.DkimKey) {
+	now := time.Now().UTC().Format(time.RFC3339)
+	key.Created, key.Activated = now, now
+	md.DkimPrivateKey = key.PrivateKey
+	md.DkimPublicKey = key.PublicKey
+	md.DkimKeys = []*models.DkimKey{key}
 }
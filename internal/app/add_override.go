@@ -0,0 +1,19 @@
+package app
+
+import (
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	apiAdd "github.com/MarlikAlmighty/mdns/internal/gen/restapi/operations/add"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+func (core *Core) AddOverrideHandler(params apiAdd.AddOverrideParams) middleware.Responder {
+
+	if err := core.Server.SetOverride(params.Add); err != nil {
+		return apiAdd.NewAddOverrideBadRequest().WithPayload(&models.Answer{
+			Code:    400,
+			Message: err.Error(),
+		})
+	}
+
+	return apiAdd.NewAddOverrideOK().WithPayload(core.Server.GetOverride(params.Add.Name))
+}
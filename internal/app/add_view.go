@@ -0,0 +1,19 @@
+package app
+
+import (
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	apiAdd "github.com/MarlikAlmighty/mdns/internal/gen/restapi/operations/add"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+func (core *Core) AddViewHandler(params apiAdd.AddViewParams) middleware.Responder {
+
+	if err := core.Server.SetView(params.Add); err != nil {
+		return apiAdd.NewAddViewBadRequest().WithPayload(&models.Answer{
+			Code:    400,
+			Message: err.Error(),
+		})
+	}
+
+	return apiAdd.NewAddViewOK().WithPayload(core.Server.GetView(params.Add.Name))
+}
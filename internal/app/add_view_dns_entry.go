@@ -0,0 +1,23 @@
+package app
+
+import (
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	apiAdd "github.com/MarlikAlmighty/mdns/internal/gen/restapi/operations/add"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+func (core *Core) AddViewDNSEntryHandler(params apiAdd.AddViewDNSEntryParams) middleware.Responder {
+
+	zones, err := core.Server.GetViewZones(params.View)
+	if err == nil {
+		var md *models.DNSEntry
+		if md, err = core.addDNSEntry(zones, params.Add); err == nil {
+			return apiAdd.NewAddViewDNSEntryOK().WithPayload(md)
+		}
+	}
+
+	return apiAdd.NewAddViewDNSEntryBadRequest().WithPayload(&models.Answer{
+		Code:    400,
+		Message: err.Error(),
+	})
+}
@@ -8,8 +8,13 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"github.com/MarlikAlmighty/mdns/internal/data"
+	mdns "github.com/MarlikAlmighty/mdns/internal/dns"
 	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/miekg/dns"
 	"net"
+	"strings"
 )
 
 type (
@@ -19,14 +24,57 @@ type (
 		ExportRsaPrivateKeyAsStr(privKey *rsa.PrivateKey) string
 		ExportRsaPublicKeyAsStr(pubKey *rsa.PublicKey) (string, error)
 		IPV4ToIPV6(ip string) (string, error)
+		CheckAddresses(ipv4s, ipv6s []string) error
+		CheckSynthesis(mode, prefix string) error
+		CheckSubnets(subnets []*models.SubnetRecords) error
+		CheckGeo(geo []*models.GeoRecords) error
+		CheckHealth(md *models.HealthCheck) error
+		CheckBalance(md *models.Balance) error
+		CheckPtrs(domain string, ptrs []*models.PtrRecord) error
+		CheckNameServers(domain string, servers []*models.NameServer) error
+		CheckDelegations(domain string, delegations []*models.Delegation) error
+		CheckSrvs(domain string, srvs []*models.SrvRecord) error
+		CheckTxts(domain string, txts []*models.TxtRecord) error
+		CheckMail(domain string, md *models.MailPolicy) error
 	}
 	// Resolver methods
 	Resolver interface {
 		Set(domain string, md *models.DNSEntry)
 		Get(domain string) *models.DNSEntry
+		Update(domain string, fn func(md *models.DNSEntry) error) error
 		Delete(domain string)
 		GetMap() map[string]models.DNSEntry
 	}
+	// Server methods of running dns server
+	Server interface {
+		SetACL(md *models.ACL) error
+		GetACL(name string) *models.ACL
+		DeleteACL(name string) error
+		GetACLs() map[string]models.ACL
+		SetBlocklist(md *models.Blocklist) error
+		GetBlocklist(name string) *models.Blocklist
+		DeleteBlocklist(name string) error
+		GetBlocklists() map[string]models.Blocklist
+		SetOverride(md *models.Override) error
+		GetOverride(name string) *models.Override
+		DeleteOverride(name string) error
+		GetOverrides() map[string]models.Override
+		SetRRL(md *models.RrlUpdate) error
+		GetRRL() *models.Rrl
+		SetView(md *models.View) error
+		GetView(name string) *models.View
+		DeleteView(name string) error
+		GetViews() map[string]models.View
+		GetViewZones(name string) (*data.ResolvedData, error)
+		Preview(domain, client string) (*models.Preview, error)
+		GetHealth(domain string) models.HealthStatusList
+		GetHealthMap() models.HealthStatuses
+		GetDKIMKeys(view, domain string) (models.DkimKeys, error)
+		AddDKIMKey(view, domain string, md *models.DkimKey) (*models.DkimKey, error)
+		RotateDKIMKey(view, domain string, md *models.DkimKey) (*models.DkimKey, error)
+		RevokeDKIMKey(view, domain, selector string) error
+		ExportDKIMKey(view, domain, selector string) (*models.DkimPem, error)
+	}
 	Config interface {
 	}
 )
@@ -64,3 +112,307 @@ func (core *Core) IPV4ToIPV6(ip string) (string, error) {
 	hex.Encode(dst, a)
 	return fmt.Sprintf("::%s:%s:%s", dst[20:24], dst[24:28], dst[28:]), nil
 }
+
+// CheckAddresses validate addresses of zone, ipv4 mapped addresses are no ipv6
+func (core *Core) CheckAddresses(ipv4s, ipv6s []string) error {
+	for _, ip := range ipv4s {
+		if a := net.ParseIP(ip); a == nil || a.To4() == nil {
+			return fmt.Errorf("invalid ipv4 %q", ip)
+		}
+	}
+	for _, ip := range ipv6s {
+		if a := net.ParseIP(ip); a == nil || a.To4() != nil {
+			return fmt.Errorf("invalid ipv6 %q", ip)
+		}
+	}
+	return nil
+}
+
+// CheckSynthesis validate mode of AAAA synthesis and prefix of nat64
+func (core *Core) CheckSynthesis(mode, prefix string) error {
+	switch mode {
+	case "", "none", "mapped":
+	case "nat64":
+		if _, err := mdns.ParseNAT64(prefix); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("invalid ipv6 synthesis %q", mode)
+	}
+	if prefix != "" && mode != "nat64" {
+		return errors.New("nat64 prefix only with nat64 synthesis")
+	}
+	return nil
+}
+
+// CheckSubnets validate networks and addresses of records for client subnets
+func (core *Core) CheckSubnets(subnets []*models.SubnetRecords) error {
+	for _, v := range subnets {
+		if v == nil {
+			continue
+		}
+		if _, _, err := net.ParseCIDR(v.Subnet); err != nil {
+			return fmt.Errorf("invalid subnet %q", v.Subnet)
+		}
+		for _, ip := range v.Ipv4s {
+			if a := net.ParseIP(ip); a == nil || a.To4() == nil {
+				return fmt.Errorf("invalid ipv4 %q of subnet %v", ip, v.Subnet)
+			}
+		}
+		for _, ip := range v.Ipv6s {
+			if a := net.ParseIP(ip); a == nil || a.To4() != nil {
+				return fmt.Errorf("invalid ipv6 %q of subnet %v", ip, v.Subnet)
+			}
+		}
+	}
+	return nil
+}
+
+// CheckGeo validate addresses of records for locations
+func (core *Core) CheckGeo(geo []*models.GeoRecords) error {
+	for _, v := range geo {
+		if v == nil {
+			continue
+		}
+		if len(v.Asns) == 0 && len(v.Countries) == 0 && len(v.Continents) == 0 {
+			return errors.New("geo records without asns, countries or continents")
+		}
+		for _, ip := range v.Ipv4s {
+			if a := net.ParseIP(ip); a == nil || a.To4() == nil {
+				return fmt.Errorf("invalid ipv4 %q of geo records", ip)
+			}
+		}
+		for _, ip := range v.Ipv6s {
+			if a := net.ParseIP(ip); a == nil || a.To4() != nil {
+				return fmt.Errorf("invalid ipv6 %q of geo records", ip)
+			}
+		}
+	}
+	return nil
+}
+
+// CheckHealth validate health check of addresses, nil check is valid
+func (core *Core) CheckHealth(md *models.HealthCheck) error {
+	if md == nil {
+		return nil
+	}
+	if err := md.Validate(strfmt.Default); err != nil {
+		return err
+	}
+	switch {
+	case md.Type == "":
+		return errors.New("health check without type")
+	case md.Port < 0 || md.Port > 65535:
+		return fmt.Errorf("invalid port %d of health check", md.Port)
+	case md.Port == 0 && (md.Type == "tcp" || md.Type == "ping"):
+		return fmt.Errorf("port required for %v health check", md.Type)
+	case md.QueryType != "" && dns.StringToType[strings.ToUpper(md.QueryType)] == 0:
+		return fmt.Errorf("invalid query type %q of health check", md.QueryType)
+	case md.Interval < 0 || md.Timeout < 0 || md.Rise < 0 || md.Fall < 0:
+		return errors.New("negative interval, timeout, rise or fall of health check")
+	}
+	return nil
+}
+
+// CheckBalance validate policy and weights of addresses, nil balance is valid
+func (core *Core) CheckBalance(md *models.Balance) error {
+	if md == nil {
+		return nil
+	}
+	if err := md.Validate(strfmt.Default); err != nil {
+		return err
+	}
+	if md.Count < 0 {
+		return fmt.Errorf("invalid count %d of balance", md.Count)
+	}
+	for k, v := range md.Weights {
+		if net.ParseIP(k) == nil {
+			return fmt.Errorf("invalid address %q of weights", k)
+		}
+		if v < 0 {
+			return fmt.Errorf("invalid weight %d of %v", v, k)
+		}
+	}
+	return nil
+}
+
+// CheckPtrs validate manual records of reverse zone, each with either ptr or cname
+func (core *Core) CheckPtrs(domain string, ptrs []*models.PtrRecord) error {
+	if len(ptrs) == 0 {
+		return nil
+	}
+	zone := strings.ToLower(dns.Fqdn(domain))
+	if !dns.IsSubDomain("in-addr.arpa.", zone) && !dns.IsSubDomain("ip6.arpa.", zone) {
+		return errors.New("ptr records only in reverse zones")
+	}
+	for _, v := range ptrs {
+		if v == nil {
+			continue
+		}
+		name := strings.ToLower(v.Name)
+		if !dns.IsFqdn(name) {
+			name = name + "." + zone
+		}
+		if _, ok := dns.IsDomainName(name); !ok || v.Name == "" || !dns.IsSubDomain(zone, name) {
+			return fmt.Errorf("invalid name %q of ptr record", v.Name)
+		}
+		if (v.Ptr == "") == (v.Cname == "") {
+			return fmt.Errorf("ptr record %q needs either ptr or cname", v.Name)
+		}
+		for _, target := range []string{v.Ptr, v.Cname} {
+			if _, ok := dns.IsDomainName(target); target != "" && !ok {
+				return fmt.Errorf("invalid target %q of ptr record", target)
+			}
+		}
+	}
+	return nil
+}
+
+// CheckNameServers validate names and addresses of name servers, names are absolute or relative to zone
+func (core *Core) CheckNameServers(domain string, servers []*models.NameServer) error {
+	zone := strings.ToLower(dns.Fqdn(domain))
+	for _, v := range servers {
+		if v == nil {
+			continue
+		}
+		name := strings.ToLower(v.Name)
+		if !dns.IsFqdn(name) {
+			name = name + "." + zone
+		}
+		if _, ok := dns.IsDomainName(name); !ok || v.Name == "" {
+			return fmt.Errorf("invalid name %q of name server", v.Name)
+		}
+		if err := core.CheckAddresses(v.Ipv4s, v.Ipv6s); err != nil {
+			return fmt.Errorf("name server %v: %v", v.Name, err)
+		}
+	}
+	return nil
+}
+
+// CheckDelegations validate child zones below zone, name servers inside child zone need glue addresses
+func (core *Core) CheckDelegations(domain string, delegations []*models.Delegation) error {
+	zone := strings.ToLower(dns.Fqdn(domain))
+	for _, v := range delegations {
+		if v == nil {
+			continue
+		}
+		child := strings.ToLower(v.Name)
+		if !dns.IsFqdn(child) {
+			child = child + "." + zone
+		}
+		if _, ok := dns.IsDomainName(child); !ok || v.Name == "" || child == zone || !dns.IsSubDomain(zone, child) {
+			return fmt.Errorf("invalid child zone %q of delegation", v.Name)
+		}
+		if len(v.NameServers) == 0 {
+			return fmt.Errorf("delegation %q without name servers", v.Name)
+		}
+		if err := core.CheckNameServers(domain, v.NameServers); err != nil {
+			return err
+		}
+		for _, ns := range v.NameServers {
+			if ns == nil {
+				continue
+			}
+			name := strings.ToLower(ns.Name)
+			if !dns.IsFqdn(name) {
+				name = name + "." + zone
+			}
+			if dns.IsSubDomain(child, name) && len(ns.Ipv4s)+len(ns.Ipv6s) == 0 {
+				return fmt.Errorf("name server %q of delegation %q needs glue addresses", ns.Name, v.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// CheckSrvs validate SRV records of services in zone, names and targets are absolute or relative to zone
+func (core *Core) CheckSrvs(domain string, srvs []*models.SrvRecord) error {
+	zone := strings.ToLower(dns.Fqdn(domain))
+	for _, v := range srvs {
+		if v == nil {
+			continue
+		}
+		name := strings.ToLower(v.Name)
+		if !dns.IsFqdn(name) {
+			name = name + "." + zone
+		}
+		if _, ok := dns.IsDomainName(name); !ok || v.Name == "" || !dns.IsSubDomain(zone, name) {
+			return fmt.Errorf("invalid name %q of srv record", v.Name)
+		}
+		if _, ok := dns.IsDomainName(v.Target); !ok || v.Target == "" {
+			return fmt.Errorf("invalid target %q of srv record", v.Target)
+		}
+		for _, n := range []int64{v.Priority, v.Weight, v.Port} {
+			if n < 0 || n > 65535 {
+				return fmt.Errorf("invalid priority, weight or port of srv record %q", v.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// CheckTxts validate TXT records of zone, names are absolute, relative to zone or @ for zone
+func (core *Core) CheckTxts(domain string, txts []*models.TxtRecord) error {
+	zone := strings.ToLower(dns.Fqdn(domain))
+	for _, v := range txts {
+		if v == nil {
+			continue
+		}
+		name := strings.ToLower(v.Name)
+		switch {
+		case name == "@":
+			name = zone
+		case !dns.IsFqdn(name):
+			name = name + "." + zone
+		}
+		if _, ok := dns.IsDomainName(name); !ok || v.Name == "" || !dns.IsSubDomain(zone, name) {
+			return fmt.Errorf("invalid name %q of txt record", v.Name)
+		}
+		if len(v.Values) == 0 {
+			return fmt.Errorf("txt record %q without values", v.Name)
+		}
+		// every string of 255 bytes costs one more byte, rdata has at most 65535 bytes
+		size := 0
+		for _, str := range v.Values {
+			size += len(str) + len(str)/255 + 1
+		}
+		if size > 65535 {
+			return fmt.Errorf("txt record %q too long", v.Name)
+		}
+	}
+	return nil
+}
+
+// CheckMail validate mail policy of zone, SPF record needs at most 10 dns lookups, URLs of BIMI are https
+func (core *Core) CheckMail(domain string, md *models.MailPolicy) error {
+	if md == nil {
+		return nil
+	}
+	entry := &models.DNSEntry{Domain: dns.Fqdn(domain)}
+	if md.Spf != nil {
+		if _, err := mdns.ParseSPF(entry, md.Spf); err != nil {
+			return err
+		}
+	}
+	if md.Dmarc != nil {
+		if _, err := mdns.ParseDMARC(entry, md.Dmarc); err != nil {
+			return err
+		}
+	}
+	if md.MtaSts != nil {
+		if _, _, err := mdns.ParseMTASTS(entry, md.MtaSts); err != nil {
+			return err
+		}
+	}
+	if md.TLSRpt != nil {
+		if _, err := mdns.ParseTLSRPT(md.TLSRpt); err != nil {
+			return err
+		}
+	}
+	if md.Bimi != nil {
+		if _, err := mdns.ParseBIMI(md.Bimi); err != nil {
+			return err
+		}
+	}
+	return nil
+}
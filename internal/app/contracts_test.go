@@ -5,6 +5,8 @@ import (
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/base64"
+	"fmt"
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
 	"testing"
 )
 
@@ -54,3 +56,112 @@ func TestIPV4ToIPV6(t *testing.T) {
 		t.Errorf("Expected %s, but got: %s", expected, result)
 	}
 }
+
+func TestCheckAddresses(t *testing.T) {
+	core := &Core{}
+	if err := core.CheckAddresses([]string{"192.0.2.1"}, []string{"2001:db8::1"}); err != nil {
+		t.Errorf("Expected no error, but got: %v", err)
+	}
+	if err := core.CheckAddresses([]string{"2001:db8::1"}, nil); err == nil {
+		t.Error("Expected error for ipv6 in ipv4s")
+	}
+	if err := core.CheckAddresses(nil, []string{"::ffff:192.0.2.1"}); err == nil {
+		t.Error("Expected error for ipv4 mapped address in ipv6s")
+	}
+}
+
+func TestCheckSynthesis(t *testing.T) {
+	core := &Core{}
+	for _, v := range [][2]string{{"", ""}, {"none", ""}, {"mapped", ""}, {"nat64", ""}, {"nat64", "2001:db8::/64"}} {
+		if err := core.CheckSynthesis(v[0], v[1]); err != nil {
+			t.Errorf("CheckSynthesis(%q, %q) = %v", v[0], v[1], err)
+		}
+	}
+	for _, v := range [][2]string{{"nat46", ""}, {"nat64", "2001:db8::/60"}, {"mapped", "64:ff9b::/96"}} {
+		if err := core.CheckSynthesis(v[0], v[1]); err == nil {
+			t.Errorf("CheckSynthesis(%q, %q) without error", v[0], v[1])
+		}
+	}
+}
+
+func TestCheckDelegations(t *testing.T) {
+	core := &Core{}
+	ok := []*models.Delegation{{Name: "sub", NameServers: []*models.NameServer{{Name: "ns.sub", Ipv4s: []string{"192.0.2.1"}}, {Name: "ns.example.net."}}}}
+	if err := core.CheckDelegations("example.com.", ok); err != nil {
+		t.Errorf("Expected no error, but got: %v", err)
+	}
+	bad := [][]*models.Delegation{
+		{{Name: "example.org.", NameServers: []*models.NameServer{{Name: "ns.example.net."}}}},
+		{{Name: "example.com.", NameServers: []*models.NameServer{{Name: "ns.example.net."}}}},
+		{{Name: "sub"}},
+		{{Name: "sub", NameServers: []*models.NameServer{{Name: "ns.sub"}}}},
+		{{Name: "sub", NameServers: []*models.NameServer{{Name: "ns.example.net.", Ipv4s: []string{"::1"}}}}},
+	}
+	for _, v := range bad {
+		if err := core.CheckDelegations("example.com.", v); err == nil {
+			t.Errorf("Expected error for delegation %v", v[0].Name)
+		}
+	}
+}
+
+func TestCheckSrvs(t *testing.T) {
+	core := &Core{}
+	if err := core.CheckSrvs("example.com.", []*models.SrvRecord{{Name: "_sip._tcp", Port: 5060, Target: "sip"}, {Name: "_ldap._tcp", Target: "."}}); err != nil {
+		t.Errorf("Expected no error, but got: %v", err)
+	}
+	bad := []*models.SrvRecord{
+		{Name: "_sip._tcp.example.org.", Target: "sip"},
+		{Name: "_sip._tcp", Target: ""},
+		{Name: "_sip._tcp", Target: "sip", Port: 70000},
+	}
+	for _, v := range bad {
+		if err := core.CheckSrvs("example.com.", []*models.SrvRecord{v}); err == nil {
+			t.Errorf("Expected error for srv record %+v", v)
+		}
+	}
+}
+
+func TestCheckTxts(t *testing.T) {
+	core := &Core{}
+	if err := core.CheckTxts("example.com.", []*models.TxtRecord{{Name: "@", Values: []string{"a"}}, {Name: "_github-challenge", Values: []string{"b"}}}); err != nil {
+		t.Errorf("Expected no error, but got: %v", err)
+	}
+	bad := []*models.TxtRecord{
+		{Name: "example.org.", Values: []string{"a"}},
+		{Name: "", Values: []string{"a"}},
+		{Name: "@"},
+	}
+	for _, v := range bad {
+		if err := core.CheckTxts("example.com.", []*models.TxtRecord{v}); err == nil {
+			t.Errorf("Expected error for txt record %+v", v)
+		}
+	}
+}
+
+func TestCheckMail(t *testing.T) {
+	core := &Core{}
+	good := &models.MailPolicy{
+		Spf:   &models.SpfPolicy{Mechanisms: []string{"mx"}, Includes: []string{"_spf.google.com"}, Qualifier: "fail"},
+		Dmarc: &models.DmarcPolicy{Policy: "reject", Rua: []string{"dmarc@example.com"}},
+	}
+	if err := core.CheckMail("example.com.", good); err != nil {
+		t.Errorf("Expected no error, but got: %v", err)
+	}
+	var includes []string
+	for i := 0; i < 11; i++ {
+		includes = append(includes, fmt.Sprintf("_spf%d.example.net", i))
+	}
+	bad := []*models.MailPolicy{
+		{Spf: &models.SpfPolicy{Includes: includes}},
+		{Spf: &models.SpfPolicy{Mechanisms: []string{"+all"}}},
+		{Dmarc: &models.DmarcPolicy{Rua: []string{"admin"}}},
+		{MtaSts: &models.MtaStsPolicy{Mode: "reject"}},
+		{TLSRpt: &models.TLSRptPolicy{}},
+		{Bimi: &models.BimiPolicy{Location: "http://example.com/logo.svg"}},
+	}
+	for _, v := range bad {
+		if err := core.CheckMail("example.com.", v); err == nil {
+			t.Errorf("Expected error for mail policy %+v", v)
+		}
+	}
+}
@@ -3,18 +3,21 @@ package app
 import (
 	"github.com/MarlikAlmighty/mdns/internal/config"
 	"github.com/MarlikAlmighty/mdns/internal/data"
+	"github.com/MarlikAlmighty/mdns/internal/dns"
 )
 
 // Core application
 type Core struct {
 	Resolver Resolver `resolver:"-"`
+	Server   Server   `server:"-"`
 	Config   Config   `config:"-"`
 }
 
 // New application core initialization
-func New(r *data.ResolvedData, c *config.Configuration) *Core {
+func New(r *data.ResolvedData, s *dns.DNS, c *config.Configuration) *Core {
 	return &Core{
 		Resolver: r,
+		Server:   s,
 		Config:   c,
 	}
 }
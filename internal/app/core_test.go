@@ -7,20 +7,45 @@ import (
 
 	"github.com/MarlikAlmighty/mdns/internal/config"
 	"github.com/MarlikAlmighty/mdns/internal/data"
+	"github.com/MarlikAlmighty/mdns/internal/dns"
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
 )
 
 func TestNew(t *testing.T) {
-	// Test case 1: New core with valid resolved data and configuration
+	// Test case 1: New core with valid resolved data, dns server and configuration
 	resolvedData := &data.ResolvedData{}
+	server := &dns.DNS{}
 	configuration := &config.Configuration{}
-	core := New(resolvedData, configuration)
+	core := New(resolvedData, server, configuration)
 	assert.NotNil(t, core)
 	assert.Equal(t, resolvedData, core.Resolver)
+	assert.Equal(t, server, core.Server)
 	assert.Equal(t, configuration, core.Config)
 
-	// Test case 2: New core with nil resolved data and configuration
-	core = New(nil, nil)
+	// Test case 2: New core with nil resolved data, dns server and configuration
+	core = New(nil, nil, nil)
 	assert.NotNil(t, core)
 	assert.Nil(t, core.Resolver)
+	assert.Nil(t, core.Server)
 	assert.Nil(t, core.Config)
 }
+
+func TestCore_addDNSEntry(t *testing.T) {
+	core := New(data.New(), &dns.DNS{}, &config.Configuration{})
+
+	// zone is stored under lowercase fully qualified name with key of default selector
+	md, err := core.addDNSEntry(core.Resolver, &models.DNSEntry{Domain: "Example.COM", Ipv4s: []string{"192.0.2.1"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "example.com.", md.Domain)
+	entry := core.Resolver.Get("example.com.")
+	if assert.Len(t, entry.DkimKeys, 1) {
+		assert.Equal(t, dns.DkimSelector, entry.DkimKeys[0].Selector)
+	}
+
+	// other spellings of domain change and delete the same zone
+	_, err = core.updateDNSEntry(core.Resolver, &models.DNSEntry{Domain: "EXAMPLE.com.", Ipv4s: []string{"192.0.2.2"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"192.0.2.2"}, core.Resolver.Get("example.com.").Ipv4s)
+	assert.NoError(t, core.deleteDNSEntry(core.Resolver, "example.Com"))
+	assert.Empty(t, core.Resolver.GetMap())
+}
@@ -1,24 +1,32 @@
 package app
 
 import (
+	"errors"
+	mdns "github.com/MarlikAlmighty/mdns/internal/dns"
 	"github.com/MarlikAlmighty/mdns/internal/gen/models"
 	apiDelete "github.com/MarlikAlmighty/mdns/internal/gen/restapi/operations/delete"
 	"github.com/go-openapi/runtime/middleware"
 )
 
 func (core *Core) DeleteDNSEntryHandler(params apiDelete.DeleteDNSEntryParams) middleware.Responder {
-
-	m := core.Resolver.Get(params.Delete.Domain)
-	if m.Domain == "" {
+	if err := core.deleteDNSEntry(core.Resolver, params.Delete.Domain); err != nil {
 		return apiDelete.NewDeleteDNSEntryBadRequest().WithPayload(&models.Answer{
 			Code:    400,
-			Message: "domain does not exist",
+			Message: err.Error(),
 		})
 	}
-
-	core.Resolver.Delete(params.Delete.Domain)
 	return apiDelete.NewDeleteDNSEntryOK().WithPayload(&models.Answer{
 		Code:    200,
 		Message: "OK",
 	})
 }
+
+// deleteDNSEntry delete existing entry from zones
+func (core *Core) deleteDNSEntry(r Resolver, domain string) error {
+	domain = mdns.DomainKey(domain)
+	if m := r.Get(domain); m.Domain == "" {
+		return errors.New("domain does not exist")
+	}
+	r.Delete(domain)
+	return nil
+}
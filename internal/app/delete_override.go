@@ -0,0 +1,22 @@
+package app
+
+import (
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	apiDelete "github.com/MarlikAlmighty/mdns/internal/gen/restapi/operations/delete"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+func (core *Core) DeleteOverrideHandler(params apiDelete.DeleteOverrideParams) middleware.Responder {
+
+	if err := core.Server.DeleteOverride(params.Delete.Name); err != nil {
+		return apiDelete.NewDeleteOverrideBadRequest().WithPayload(&models.Answer{
+			Code:    400,
+			Message: err.Error(),
+		})
+	}
+
+	return apiDelete.NewDeleteOverrideOK().WithPayload(&models.Answer{
+		Code:    200,
+		Message: "OK",
+	})
+}
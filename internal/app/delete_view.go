@@ -0,0 +1,22 @@
+package app
+
+import (
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	apiDelete "github.com/MarlikAlmighty/mdns/internal/gen/restapi/operations/delete"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+func (core *Core) DeleteViewHandler(params apiDelete.DeleteViewParams) middleware.Responder {
+
+	if err := core.Server.DeleteView(params.Delete.Name); err != nil {
+		return apiDelete.NewDeleteViewBadRequest().WithPayload(&models.Answer{
+			Code:    400,
+			Message: err.Error(),
+		})
+	}
+
+	return apiDelete.NewDeleteViewOK().WithPayload(&models.Answer{
+		Code:    200,
+		Message: "OK",
+	})
+}
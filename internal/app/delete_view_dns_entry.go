@@ -0,0 +1,26 @@
+package app
+
+import (
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	apiDelete "github.com/MarlikAlmighty/mdns/internal/gen/restapi/operations/delete"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+func (core *Core) DeleteViewDNSEntryHandler(params apiDelete.DeleteViewDNSEntryParams) middleware.Responder {
+
+	zones, err := core.Server.GetViewZones(params.View)
+	if err == nil {
+		err = core.deleteDNSEntry(zones, params.Delete.Domain)
+	}
+	if err != nil {
+		return apiDelete.NewDeleteViewDNSEntryBadRequest().WithPayload(&models.Answer{
+			Code:    400,
+			Message: err.Error(),
+		})
+	}
+
+	return apiDelete.NewDeleteViewDNSEntryOK().WithPayload(&models.Answer{
+		Code:    200,
+		Message: "OK",
+	})
+}
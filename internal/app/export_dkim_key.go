@@ -0,0 +1,21 @@
+package app
+
+import (
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	apiShow "github.com/MarlikAlmighty/mdns/internal/gen/restapi/operations/show"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+func (core *Core) ExportDkimKeyHandler(params apiShow.ExportDkimKeyParams) middleware.Responder {
+
+	md, err := core.Server.ExportDKIMKey(swag.StringValue(params.View), params.Domain, params.Selector)
+	if err != nil {
+		return apiShow.NewExportDkimKeyBadRequest().WithPayload(&models.Answer{
+			Code:    400,
+			Message: err.Error(),
+		})
+	}
+
+	return apiShow.NewExportDkimKeyOK().WithPayload(md)
+}
@@ -0,0 +1,10 @@
+package app
+
+import (
+	apiShow "github.com/MarlikAlmighty/mdns/internal/gen/restapi/operations/show"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+func (core *Core) ListOneACLHandler(params apiShow.ListOneACLParams) middleware.Responder {
+	return apiShow.NewListOneACLOK().WithPayload(core.Server.GetACL(params.Name))
+}
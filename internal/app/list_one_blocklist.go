@@ -0,0 +1,10 @@
+package app
+
+import (
+	apiShow "github.com/MarlikAlmighty/mdns/internal/gen/restapi/operations/show"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+func (core *Core) ListOneBlocklistHandler(params apiShow.ListOneBlocklistParams) middleware.Responder {
+	return apiShow.NewListOneBlocklistOK().WithPayload(core.Server.GetBlocklist(params.Name))
+}
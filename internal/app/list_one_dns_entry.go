@@ -1,10 +1,11 @@
 package app
 
 import (
+	mdns "github.com/MarlikAlmighty/mdns/internal/dns"
 	apiShow "github.com/MarlikAlmighty/mdns/internal/gen/restapi/operations/show"
 	"github.com/go-openapi/runtime/middleware"
 )
 
 func (core *Core) ListOneDNSEntryHandler(params apiShow.ListOneDNSEntryParams) middleware.Responder {
-	return apiShow.NewListOneDNSEntryOK().WithPayload(core.Resolver.Get(params.Domain))
+	return apiShow.NewListOneDNSEntryOK().WithPayload(core.Resolver.Get(mdns.DomainKey(params.Domain)))
 }
@@ -0,0 +1,10 @@
+package app
+
+import (
+	apiShow "github.com/MarlikAlmighty/mdns/internal/gen/restapi/operations/show"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+func (core *Core) ListOneHealthHandler(params apiShow.ListOneHealthParams) middleware.Responder {
+	return apiShow.NewListOneHealthOK().WithPayload(core.Server.GetHealth(params.Domain))
+}
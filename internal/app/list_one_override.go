@@ -0,0 +1,10 @@
+package app
+
+import (
+	apiShow "github.com/MarlikAlmighty/mdns/internal/gen/restapi/operations/show"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+func (core *Core) ListOneOverrideHandler(params apiShow.ListOneOverrideParams) middleware.Responder {
+	return apiShow.NewListOneOverrideOK().WithPayload(core.Server.GetOverride(params.Name))
+}
@@ -0,0 +1,10 @@
+package app
+
+import (
+	apiShow "github.com/MarlikAlmighty/mdns/internal/gen/restapi/operations/show"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+func (core *Core) ListOneViewHandler(params apiShow.ListOneViewParams) middleware.Responder {
+	return apiShow.NewListOneViewOK().WithPayload(core.Server.GetView(params.View))
+}
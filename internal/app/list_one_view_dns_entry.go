@@ -0,0 +1,21 @@
+package app
+
+import (
+	mdns "github.com/MarlikAlmighty/mdns/internal/dns"
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	apiShow "github.com/MarlikAlmighty/mdns/internal/gen/restapi/operations/show"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+func (core *Core) ListOneViewDNSEntryHandler(params apiShow.ListOneViewDNSEntryParams) middleware.Responder {
+
+	zones, err := core.Server.GetViewZones(params.View)
+	if err != nil {
+		return apiShow.NewListOneViewDNSEntryBadRequest().WithPayload(&models.Answer{
+			Code:    400,
+			Message: err.Error(),
+		})
+	}
+
+	return apiShow.NewListOneViewDNSEntryOK().WithPayload(zones.Get(mdns.DomainKey(params.Domain)))
+}
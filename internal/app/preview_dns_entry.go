@@ -0,0 +1,20 @@
+package app
+
+import (
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	apiShow "github.com/MarlikAlmighty/mdns/internal/gen/restapi/operations/show"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+func (core *Core) PreviewDNSEntryHandler(params apiShow.PreviewDNSEntryParams) middleware.Responder {
+
+	md, err := core.Server.Preview(params.Domain, params.IP)
+	if err != nil {
+		return apiShow.NewPreviewDNSEntryBadRequest().WithPayload(&models.Answer{
+			Code:    400,
+			Message: err.Error(),
+		})
+	}
+
+	return apiShow.NewPreviewDNSEntryOK().WithPayload(md)
+}
@@ -0,0 +1,23 @@
+package app
+
+import (
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	apiDelete "github.com/MarlikAlmighty/mdns/internal/gen/restapi/operations/delete"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+func (core *Core) RevokeDkimKeyHandler(params apiDelete.RevokeDkimKeyParams) middleware.Responder {
+
+	if err := core.Server.RevokeDKIMKey(swag.StringValue(params.View), params.Domain, params.Revoke.Selector); err != nil {
+		return apiDelete.NewRevokeDkimKeyBadRequest().WithPayload(&models.Answer{
+			Code:    400,
+			Message: err.Error(),
+		})
+	}
+
+	return apiDelete.NewRevokeDkimKeyOK().WithPayload(&models.Answer{
+		Code:    200,
+		Message: "OK",
+	})
+}
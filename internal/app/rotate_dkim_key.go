@@ -0,0 +1,21 @@
+package app
+
+import (
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	apiUpdate "github.com/MarlikAlmighty/mdns/internal/gen/restapi/operations/update"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+func (core *Core) RotateDkimKeyHandler(params apiUpdate.RotateDkimKeyParams) middleware.Responder {
+
+	md, err := core.Server.RotateDKIMKey(swag.StringValue(params.View), params.Domain, params.Rotate)
+	if err != nil {
+		return apiUpdate.NewRotateDkimKeyBadRequest().WithPayload(&models.Answer{
+			Code:    400,
+			Message: err.Error(),
+		})
+	}
+
+	return apiUpdate.NewRotateDkimKeyOK().WithPayload(md)
+}
@@ -0,0 +1,10 @@
+package app
+
+import (
+	apiList "github.com/MarlikAlmighty/mdns/internal/gen/restapi/operations/list"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+func (core *Core) ShowAclsHandler(_ apiList.ShowAclsParams) middleware.Responder {
+	return apiList.NewShowAclsOK().WithPayload(core.Server.GetACLs())
+}
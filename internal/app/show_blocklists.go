@@ -0,0 +1,10 @@
+package app
+
+import (
+	apiList "github.com/MarlikAlmighty/mdns/internal/gen/restapi/operations/list"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+func (core *Core) ShowBlocklistsHandler(_ apiList.ShowBlocklistsParams) middleware.Responder {
+	return apiList.NewShowBlocklistsOK().WithPayload(core.Server.GetBlocklists())
+}
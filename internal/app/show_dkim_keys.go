@@ -0,0 +1,21 @@
+package app
+
+import (
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	apiList "github.com/MarlikAlmighty/mdns/internal/gen/restapi/operations/list"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+func (core *Core) ShowDkimKeysHandler(params apiList.ShowDkimKeysParams) middleware.Responder {
+
+	md, err := core.Server.GetDKIMKeys(swag.StringValue(params.View), params.Domain)
+	if err != nil {
+		return apiList.NewShowDkimKeysBadRequest().WithPayload(&models.Answer{
+			Code:    400,
+			Message: err.Error(),
+		})
+	}
+
+	return apiList.NewShowDkimKeysOK().WithPayload(md)
+}
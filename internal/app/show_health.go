@@ -0,0 +1,10 @@
+package app
+
+import (
+	apiList "github.com/MarlikAlmighty/mdns/internal/gen/restapi/operations/list"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+func (core *Core) ShowHealthHandler(_ apiList.ShowHealthParams) middleware.Responder {
+	return apiList.NewShowHealthOK().WithPayload(core.Server.GetHealthMap())
+}
@@ -0,0 +1,25 @@
+package app
+
+import (
+	mdns "github.com/MarlikAlmighty/mdns/internal/dns"
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	apiShow "github.com/MarlikAlmighty/mdns/internal/gen/restapi/operations/show"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+func (core *Core) ShowMailPolicyHandler(params apiShow.ShowMailPolicyParams) middleware.Responder {
+
+	md := core.Resolver.Get(mdns.DomainKey(params.Domain))
+	if md.Domain == "" {
+		return apiShow.NewShowMailPolicyBadRequest().WithPayload(&models.Answer{
+			Code:    400,
+			Message: "domain does not exist",
+		})
+	}
+
+	if md.Mail == nil {
+		md.Mail = &models.MailPolicy{}
+	}
+
+	return apiShow.NewShowMailPolicyOK().WithPayload(md.Mail)
+}
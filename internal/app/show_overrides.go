@@ -0,0 +1,10 @@
+package app
+
+import (
+	apiList "github.com/MarlikAlmighty/mdns/internal/gen/restapi/operations/list"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+func (core *Core) ShowOverridesHandler(_ apiList.ShowOverridesParams) middleware.Responder {
+	return apiList.NewShowOverridesOK().WithPayload(core.Server.GetOverrides())
+}
@@ -0,0 +1,10 @@
+package app
+
+import (
+	apiShow "github.com/MarlikAlmighty/mdns/internal/gen/restapi/operations/show"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+func (core *Core) ShowRrlHandler(_ apiShow.ShowRrlParams) middleware.Responder {
+	return apiShow.NewShowRrlOK().WithPayload(core.Server.GetRRL())
+}
@@ -0,0 +1,20 @@
+package app
+
+import (
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	apiList "github.com/MarlikAlmighty/mdns/internal/gen/restapi/operations/list"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+func (core *Core) ShowViewDNSRecordsHandler(params apiList.ShowViewDNSRecordsParams) middleware.Responder {
+
+	zones, err := core.Server.GetViewZones(params.View)
+	if err != nil {
+		return apiList.NewShowViewDNSRecordsBadRequest().WithPayload(&models.Answer{
+			Code:    400,
+			Message: err.Error(),
+		})
+	}
+
+	return apiList.NewShowViewDNSRecordsOK().WithPayload(zones.GetMap())
+}
@@ -0,0 +1,10 @@
+package app
+
+import (
+	apiList "github.com/MarlikAlmighty/mdns/internal/gen/restapi/operations/list"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+func (core *Core) ShowViewsHandler(_ apiList.ShowViewsParams) middleware.Responder {
+	return apiList.NewShowViewsOK().WithPayload(core.Server.GetViews())
+}
@@ -1,44 +1,110 @@
 package app
 
 import (
+	"errors"
+	mdns "github.com/MarlikAlmighty/mdns/internal/dns"
 	"github.com/MarlikAlmighty/mdns/internal/gen/models"
-	apiAdd "github.com/MarlikAlmighty/mdns/internal/gen/restapi/operations/add"
 	apiUpdate "github.com/MarlikAlmighty/mdns/internal/gen/restapi/operations/update"
 	"github.com/go-openapi/runtime/middleware"
 )
 
 func (core *Core) UpdateDNSEntryHandler(params apiUpdate.UpdateDNSEntryParams) middleware.Responder {
-
-	m := core.Resolver.Get(params.Update.Domain)
-	if m.Domain == "" {
+	m, err := core.updateDNSEntry(core.Resolver, params.Update)
+	if err != nil {
 		return apiUpdate.NewUpdateDNSEntryBadRequest().WithPayload(&models.Answer{
 			Code:    400,
-			Message: "domain does not exist",
+			Message: err.Error(),
 		})
 	}
+	return apiUpdate.NewUpdateDNSEntryOK().WithPayload(m)
+}
+
+// updateDNSEntry replace addresses of existing entry in zones
+func (core *Core) updateDNSEntry(r Resolver, update *models.DNSEntry) (*models.DNSEntry, error) {
 
-	m.Domain = params.Update.Domain
-	m.Ipv4s = params.Update.Ipv4s
-	m.Acme = params.Update.Acme
+	update.Domain = mdns.DomainKey(update.Domain)
 
-	var (
-		ipv6 string
-		err  error
-	)
+	if err := core.CheckSubnets(update.Subnets); err != nil {
+		return nil, err
+	}
 
-	m.Ipv6s = []string{}
+	if err := core.CheckGeo(update.Geo); err != nil {
+		return nil, err
+	}
 
-	for _, v := range params.Update.Ipv4s {
-		ipv6, err = core.IPV4ToIPV6(v)
-		if err != nil {
-			return apiAdd.NewAddDNSEntryBadRequest().WithPayload(&models.Answer{
-				Code:    400,
-				Message: "can't convert ipv4 to ipv6",
-			})
-		}
-		m.Ipv6s = append(m.Ipv6s, ipv6)
+	if err := core.CheckHealth(update.HealthCheck); err != nil {
+		return nil, err
 	}
 
-	core.Resolver.Set(m.Domain, m)
-	return apiUpdate.NewUpdateDNSEntryOK().WithPayload(m)
+	if err := core.CheckBalance(update.Balance); err != nil {
+		return nil, err
+	}
+
+	if err := core.CheckPtrs(update.Domain, update.Ptrs); err != nil {
+		return nil, err
+	}
+
+	if err := core.CheckAddresses(update.Ipv4s, update.Ipv6s); err != nil {
+		return nil, err
+	}
+
+	if err := core.CheckSynthesis(update.IPV6Synthesis, update.Nat64Prefix); err != nil {
+		return nil, err
+	}
+
+	if err := core.CheckNameServers(update.Domain, update.NameServers); err != nil {
+		return nil, err
+	}
+
+	if err := core.CheckDelegations(update.Domain, update.Delegations); err != nil {
+		return nil, err
+	}
+
+	if err := core.CheckSrvs(update.Domain, update.Srvs); err != nil {
+		return nil, err
+	}
+
+	if err := core.CheckTxts(update.Domain, update.Txts); err != nil {
+		return nil, err
+	}
+
+	if err := core.CheckMail(update.Domain, update.Mail); err != nil {
+		return nil, err
+	}
+
+	// other fields like dkim keys change meanwhile, the entry is changed in place
+	var out models.DNSEntry
+	err := r.Update(update.Domain, func(m *models.DNSEntry) error {
+
+		if m.Domain == "" {
+			return errors.New("domain does not exist")
+		}
+
+		m.Domain = update.Domain
+		m.Ipv4s = update.Ipv4s
+		m.Ipv6s = update.Ipv6s
+		m.IPV6Synthesis = update.IPV6Synthesis
+		m.Nat64Prefix = update.Nat64Prefix
+		m.NameServers = update.NameServers
+		m.Delegations = update.Delegations
+		m.Srvs = update.Srvs
+		m.Txts = update.Txts
+		// mail policy is kept when it is left out, it has its own endpoint
+		if update.Mail != nil {
+			m.Mail = update.Mail
+		}
+		m.Acme = update.Acme
+		m.Subnets = update.Subnets
+		m.Geo = update.Geo
+		m.HealthCheck = update.HealthCheck
+		m.Balance = update.Balance
+		m.AutoPtr = update.AutoPtr
+		m.Ptrs = update.Ptrs
+		out = *m
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
 }
@@ -0,0 +1,40 @@
+package app
+
+import (
+	"errors"
+	mdns "github.com/MarlikAlmighty/mdns/internal/dns"
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	apiUpdate "github.com/MarlikAlmighty/mdns/internal/gen/restapi/operations/update"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+func (core *Core) UpdateMailPolicyHandler(params apiUpdate.UpdateMailPolicyParams) middleware.Responder {
+	md, err := core.updateMailPolicy(core.Resolver, params.Domain, params.Update)
+	if err != nil {
+		return apiUpdate.NewUpdateMailPolicyBadRequest().WithPayload(&models.Answer{
+			Code:    400,
+			Message: err.Error(),
+		})
+	}
+	return apiUpdate.NewUpdateMailPolicyOK().WithPayload(md)
+}
+
+// updateMailPolicy replace mail policy of existing entry, SPF and DMARC records follow it
+func (core *Core) updateMailPolicy(r Resolver, domain string, update *models.MailPolicy) (*models.MailPolicy, error) {
+
+	if err := core.CheckMail(domain, update); err != nil {
+		return nil, err
+	}
+
+	err := r.Update(mdns.DomainKey(domain), func(m *models.DNSEntry) error {
+		if m.Domain == "" {
+			return errors.New("domain does not exist")
+		}
+		m.Mail = update
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return update, nil
+}
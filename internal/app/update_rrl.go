@@ -0,0 +1,19 @@
+package app
+
+import (
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	apiUpdate "github.com/MarlikAlmighty/mdns/internal/gen/restapi/operations/update"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+func (core *Core) UpdateRrlHandler(params apiUpdate.UpdateRrlParams) middleware.Responder {
+
+	if err := core.Server.SetRRL(params.Update); err != nil {
+		return apiUpdate.NewUpdateRrlBadRequest().WithPayload(&models.Answer{
+			Code:    400,
+			Message: err.Error(),
+		})
+	}
+
+	return apiUpdate.NewUpdateRrlOK().WithPayload(core.Server.GetRRL())
+}
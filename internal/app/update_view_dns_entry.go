@@ -0,0 +1,23 @@
+package app
+
+import (
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	apiUpdate "github.com/MarlikAlmighty/mdns/internal/gen/restapi/operations/update"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+func (core *Core) UpdateViewDNSEntryHandler(params apiUpdate.UpdateViewDNSEntryParams) middleware.Responder {
+
+	zones, err := core.Server.GetViewZones(params.View)
+	if err == nil {
+		var md *models.DNSEntry
+		if md, err = core.updateDNSEntry(zones, params.Update); err == nil {
+			return apiUpdate.NewUpdateViewDNSEntryOK().WithPayload(md)
+		}
+	}
+
+	return apiUpdate.NewUpdateViewDNSEntryBadRequest().WithPayload(&models.Answer{
+		Code:    400,
+		Message: err.Error(),
+	})
+}
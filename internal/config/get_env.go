@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/kelseyhightower/envconfig"
 )
 
@@ -10,6 +12,83 @@ type Configuration struct {
 	DnsTcpPort  string   `required:"true" split_words:"true"`
 	DnsUdpPort  string   `required:"true" split_words:"true"`
 	NameServers []string `required:"true" split_words:"true"`
+	// host of rest api and listen addresses "proto=addr:port" of udp, tcp, tls, quic, https instead of ports
+	HTTPHost string   `default:"127.0.0.1" split_words:"true"`
+	Listen   []string `split_words:"true"`
+	// forward to name servers or resolve from the root servers: forward, iterative
+	ResolveMode       string   `default:"forward" split_words:"true"`
+	RootServers       []string `split_words:"true"`
+	QnameMinimisation bool     `default:"true" split_words:"true"`
+	// named access lists "name=10.0.0.0/8 !10.1.0.0/16 key:office." and tsig keys "name=secret"
+	Acls           []string `split_words:"true"`
+	TsigKeys       []string `split_words:"true"`
+	AllowRecursion []string `default:"localnets" split_words:"true"`
+	AllowTransfer  []string `default:"none" split_words:"true"`
+	AllowUpdate    []string `default:"none" split_words:"true"`
+	// split horizon views "name=10.0.0.0/8 key:office. listener:10.0.0.1:53" with own zones, matched in given order
+	Views []string `split_words:"true"`
+	// block lists "name=format source [group ...]", "+name=..." for allow lists, checked in given order
+	Blocklists       []string      `split_words:"true"`
+	BlocklistRefresh time.Duration `default:"24h" split_words:"true"`
+	BlockResponse    []string      `default:"nxdomain" split_words:"true"`
+	// hosts file with static overrides of exact names, watched for changes
+	HostsFile  string        `split_words:"true"`
+	HostsWatch time.Duration `default:"5s" split_words:"true"`
+	// dns over tls and quic, disabled without port; certificate files are reloaded when they change
+	DnsTlsPort        string        `split_words:"true"`
+	DnsQuicPort       string        `split_words:"true"`
+	DnsTlsIdleTimeout time.Duration `default:"10s" split_words:"true"`
+	DnsTlsMaxQueries  int           `default:"128" split_words:"true"`
+	TlsCertFile       string        `split_words:"true"`
+	TlsKeyFile        string        `split_words:"true"`
+	// certificates from ACME CA by tls-alpn-01, which needs https listener on 443, for given hosts,
+	// name servers of served zones and mta-sts.<zone>; certificate files for other names
+	TlsAcme          bool     `split_words:"true"`
+	TlsAcmeEmail     string   `split_words:"true"`
+	TlsAcmeCacheDir  string   `default:"acme" split_words:"true"`
+	TlsAcmeDirectory string   `split_words:"true"`
+	TlsAcmeHosts     []string `split_words:"true"`
+	// largest udp payload of EDNS replies, bigger answers are truncated and retried over tcp
+	EdnsUdpSize uint16 `default:"1232" split_words:"true"`
+	// answers without addresses of MX, NS and SRV targets in additional section
+	MinimalResponses bool `split_words:"true"`
+	// EDNS client subnet of upstream queries: off strips it, add sends subnet of client shortened to prefix lengths
+	EcsUpstream         string `default:"off" split_words:"true"`
+	EcsIpv4PrefixLength int    `default:"24" split_words:"true"`
+	EcsIpv6PrefixLength int    `default:"56" split_words:"true"`
+	// answers of upstream cached per subnet of their scope, disabled with zero size
+	CacheSize int `default:"10000" split_words:"true"`
+	// DNS64 of forwarded AAAA queries for access lists of clients behind NAT64, excluding
+	// ipv6 networks of upstream AAAA records, ipv4 networks of A records and domain names
+	Dns64Clients []string `default:"none" split_words:"true"`
+	Dns64Prefix  string   `default:"64:ff9b::/96" split_words:"true"`
+	Dns64Exclude []string `default:"::ffff:0:0/96" split_words:"true"`
+	// MaxMind databases of locations for geo records, e.g. country or city database and asn database
+	GeoipFiles []string `split_words:"true"`
+	// DKIM keys: new selectors are published before they sign, retired selectors stay published,
+	// keys are rotated every interval, zero disables scheduled rotation
+	DkimPublish time.Duration `default:"72h" split_words:"true"`
+	DkimRetire  time.Duration `default:"168h" split_words:"true"`
+	DkimRotate  time.Duration `split_words:"true"`
+	// addresses of policy host mta-sts.<zone> of zones with MTA-STS policy, https listeners serve
+	// its mta-sts.txt; addresses of zone by default
+	MtaStsAddresses []string `split_words:"true"`
+	// dns cookies, shared hex secret for anycast servers or random secret rotated every interval
+	CookieSecret  string        `split_words:"true"`
+	CookieRotate  time.Duration `default:"24h" split_words:"true"`
+	CookieRequire bool          `split_words:"true"`
+	// response rate limiting of udp answers, disabled with zero responses per second
+	RrlResponsesPerSecond int64    `split_words:"true"`
+	RrlNxdomainsPerSecond int64    `split_words:"true"`
+	RrlErrorsPerSecond    int64    `split_words:"true"`
+	RrlWindow             int64    `default:"15" split_words:"true"`
+	RrlSlip               int64    `default:"2" split_words:"true"`
+	RrlIpv4PrefixLength   int64    `default:"24" split_words:"true"`
+	RrlIpv6PrefixLength   int64    `default:"56" split_words:"true"`
+	RrlExempt             []string `split_words:"true"`
+	// dns over https on /dns-query and /resolve, on own tls port and/or, when enabled, on port of rest api
+	DohPort  string `split_words:"true"`
+	DohOnAPI bool   `split_words:"true"`
 }
 
 func New() *Configuration {
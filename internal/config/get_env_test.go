@@ -28,6 +28,14 @@ func TestConfiguration_GetEnv(t *testing.T) {
 	if err := cfg.GetEnv(); err != nil {
 		t.Errorf("Error: %v", err)
 	}
+	if cfg.DohOnAPI {
+		t.Error("dns over https on port of rest api by default")
+	}
+
+	t.Setenv("DOH_ON_API", "true")
+	if err := cfg.GetEnv(); err != nil || !cfg.DohOnAPI {
+		t.Errorf("GetEnv() with DOH_ON_API = %v, error = %v", cfg.DohOnAPI, err)
+	}
 
 	tests := []struct {
 		name    string
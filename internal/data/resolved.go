@@ -9,6 +9,7 @@ import (
 type Resolver interface {
 	Set(domain string, md *models.DNSEntry)
 	Get(domain string) *models.DNSEntry
+	Update(domain string, fn func(md *models.DNSEntry) error) error
 	Delete(domain string)
 	GetMap() map[string]models.DNSEntry
 }
@@ -41,6 +42,19 @@ func (r *ResolvedData) Get(domain string) *models.DNSEntry {
 	return &md
 }
 
+// Update change copy of entry with fn and store it when fn succeeds, nobody else writes the entry meanwhile;
+// fn gets an empty entry for unknown domain and must not call other methods of r
+func (r *ResolvedData) Update(domain string, fn func(md *models.DNSEntry) error) error {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	md := r.Records[domain]
+	if err := fn(&md); err != nil {
+		return err
+	}
+	r.Records[domain] = md
+	return nil
+}
+
 // Delete record from map
 func (r *ResolvedData) Delete(domain string) {
 	r.mux.Lock()
@@ -48,10 +62,13 @@ func (r *ResolvedData) Delete(domain string) {
 	r.mux.Unlock()
 }
 
-// GetMap get all map
+// GetMap get copy of all map, safe to range while records change
 func (r *ResolvedData) GetMap() map[string]models.DNSEntry {
 	r.mux.Lock()
-	mp := r.Records
+	mp := make(map[string]models.DNSEntry, len(r.Records))
+	for k, v := range r.Records {
+		mp[k] = v
+	}
 	r.mux.Unlock()
 	return mp
 }
@@ -0,0 +1,267 @@
+package dns
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	"github.com/miekg/dns"
+)
+
+// built-in access lists, can not be replaced
+const (
+	aclAny       = "any"
+	aclNone      = "none"
+	aclLocalhost = "localhost"
+	aclLocalnets = "localnets"
+)
+
+var (
+	errACLName    = errors.New("acl: empty name")
+	errACLBuiltIn = errors.New("acl: built-in list can not be changed")
+	errACLUnknown = errors.New("acl: list does not exist")
+)
+
+// element one compiled entry of access list
+type element struct {
+	deny bool
+	nets *net.IPNet
+	key  string
+	ref  string
+}
+
+// ACL named access lists of networks and tsig keys
+type ACL struct {
+	models map[string]models.ACL
+	lists  map[string][]element
+	mux    sync.RWMutex
+}
+
+// NewACL simple constructor
+func NewACL() *ACL {
+	return &ACL{
+		models: make(map[string]models.ACL),
+		lists:  make(map[string][]element),
+	}
+}
+
+// ParseACL parse definition like "name=10.0.0.0/8 !10.1.0.0/16 key:office."
+func ParseACL(def string) (*models.ACL, error) {
+	kv := strings.SplitN(def, "=", 2)
+	if len(kv) != 2 {
+		return nil, fmt.Errorf("acl: invalid definition %q", def)
+	}
+	return &models.ACL{
+		Name:     strings.TrimSpace(kv[0]),
+		Elements: strings.Fields(kv[1]),
+	}, nil
+}
+
+// Set validate and save access list
+func (a *ACL) Set(md *models.ACL) error {
+
+	name := strings.ToLower(md.Name)
+
+	if name == "" {
+		return errACLName
+	}
+
+	if isBuiltIn(name) {
+		return errACLBuiltIn
+	}
+
+	var list []element
+	for _, v := range md.Elements {
+		e, err := compile(v)
+		if err != nil {
+			return err
+		}
+		list = append(list, e)
+	}
+
+	a.mux.Lock()
+	a.models[name] = models.ACL{Name: name, Elements: md.Elements}
+	a.lists[name] = list
+	a.mux.Unlock()
+	return nil
+}
+
+// Get fetch access list by name
+func (a *ACL) Get(name string) *models.ACL {
+	a.mux.RLock()
+	md := a.models[strings.ToLower(name)]
+	a.mux.RUnlock()
+	return &md
+}
+
+// Delete access list by name
+func (a *ACL) Delete(name string) error {
+	name = strings.ToLower(name)
+	a.mux.Lock()
+	defer a.mux.Unlock()
+	if _, ok := a.lists[name]; !ok {
+		return errACLUnknown
+	}
+	delete(a.models, name)
+	delete(a.lists, name)
+	return nil
+}
+
+// GetMap get all access lists
+func (a *ACL) GetMap() map[string]models.ACL {
+	a.mux.RLock()
+	mp := make(map[string]models.ACL, len(a.models))
+	for k, v := range a.models {
+		mp[k] = v
+	}
+	a.mux.RUnlock()
+	return mp
+}
+
+// Check names of lists, built-in or defined
+func (a *ACL) Check(names []string) error {
+	a.mux.RLock()
+	defer a.mux.RUnlock()
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if _, ok := a.lists[name]; !ok && !isBuiltIn(name) {
+			return fmt.Errorf("%w: %q", errACLUnknown, name)
+		}
+	}
+	return nil
+}
+
+// Allowed check client address and verified tsig key against lists, first matched element wins
+func (a *ACL) Allowed(names []string, ip net.IP, key string) bool {
+	a.mux.RLock()
+	defer a.mux.RUnlock()
+	for _, name := range names {
+		if allow, ok := a.match(strings.ToLower(name), ip, key, 0); ok {
+			return allow
+		}
+	}
+	return false
+}
+
+// match one list, returns result and whether some element matched
+func (a *ACL) match(name string, ip net.IP, key string, depth int) (bool, bool) {
+
+	// nested lists referring to each other
+	if depth > 8 {
+		return false, false
+	}
+
+	switch name {
+	case aclAny:
+		return true, true
+	case aclNone:
+		return false, false
+	case aclLocalhost:
+		return ip != nil && ip.IsLoopback(), ip != nil && ip.IsLoopback()
+	case aclLocalnets:
+		ok := ip != nil && (ip.IsLoopback() || ip.IsPrivate())
+		return ok, ok
+	}
+
+	return a.matchList(a.lists[name], ip, key, depth)
+}
+
+// matchList elements of one list, returns result and whether some element matched
+func (a *ACL) matchList(list []element, ip net.IP, key string, depth int) (bool, bool) {
+
+	for _, e := range list {
+		allow, ok := true, false
+		switch {
+		case e.nets != nil:
+			ok = ip != nil && e.nets.Contains(ip)
+		case e.key != "":
+			ok = key != "" && e.key == key
+		case e.ref != "":
+			allow, ok = a.match(e.ref, ip, key, depth+1)
+		}
+		if ok {
+			return allow != e.deny, true
+		}
+	}
+
+	return false, false
+}
+
+// matches check client against elements which are not saved as named list, first matched element wins
+func (a *ACL) matches(list []element, ip net.IP, key string) bool {
+	a.mux.RLock()
+	defer a.mux.RUnlock()
+	allow, _ := a.matchList(list, ip, key, 0)
+	return allow
+}
+
+// compile one element of access list
+func compile(v string) (element, error) {
+
+	var e element
+
+	v = strings.TrimSpace(v)
+	if strings.HasPrefix(v, "!") {
+		e.deny = true
+		v = strings.TrimPrefix(v, "!")
+	}
+
+	switch {
+	case v == "":
+		return e, errors.New("acl: empty element")
+	case strings.HasPrefix(v, "key:"):
+		e.key = dns.CanonicalName(strings.TrimPrefix(v, "key:"))
+	case strings.Contains(v, "/"):
+		_, nets, err := net.ParseCIDR(v)
+		if err != nil {
+			return e, fmt.Errorf("acl: %w", err)
+		}
+		e.nets = nets
+	case net.ParseIP(v) != nil:
+		ip := net.ParseIP(v)
+		bits := 128
+		if ip.To4() != nil {
+			ip, bits = ip.To4(), 32
+		}
+		e.nets = &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}
+	default:
+		e.ref = strings.ToLower(v)
+	}
+
+	return e, nil
+}
+
+// isBuiltIn check name of built-in list
+func isBuiltIn(name string) bool {
+	switch name {
+	case aclAny, aclNone, aclLocalhost, aclLocalnets:
+		return true
+	}
+	return false
+}
+
+// tsigKey name of key which signed request, empty if request is not signed or signature is invalid
+func tsigKey(w dns.ResponseWriter, r *dns.Msg) string {
+	if t := r.IsTsig(); t != nil && w.TsigStatus() == nil {
+		return dns.CanonicalName(t.Hdr.Name)
+	}
+	return ""
+}
+
+// tsigSecrets parse key definitions like "name=base64 secret"
+func tsigSecrets(keys []string) map[string]string {
+	secrets := make(map[string]string, len(keys))
+	for _, v := range keys {
+		kv := strings.SplitN(v, "=", 2)
+		if len(kv) != 2 {
+			log.Printf("[ERR]: invalid tsig key %q\n", kv[0])
+			continue
+		}
+		secrets[dns.CanonicalName(strings.TrimSpace(kv[0]))] = strings.TrimSpace(kv[1])
+	}
+	return secrets
+}
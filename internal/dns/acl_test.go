@@ -0,0 +1,94 @@
+package dns
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+func TestACL_Allowed(t *testing.T) {
+
+	acl := NewACL()
+	for _, v := range []string{
+		"vpn=100.64.0.0/10",
+		"office=!192.168.1.128/25 192.168.1.0/24 key:office.",
+		"trusted=vpn office 2001:db8::1",
+	} {
+		md, err := ParseACL(v)
+		if err != nil {
+			t.Fatalf("ParseACL() error = %v", err)
+		}
+		if err = acl.Set(md); err != nil {
+			t.Fatalf("Set() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		name  string
+		lists []string
+		ip    string
+		key   string
+		want  bool
+	}{
+		{"cgnat_allowed", []string{"vpn"}, "100.64.1.1", "", true},
+		{"public_denied", []string{"vpn"}, "8.8.8.8", "", false},
+		{"localnets_private", []string{"localnets"}, "10.0.0.1", "", true},
+		{"localnets_public", []string{"localnets"}, "1.1.1.1", "", false},
+		{"localnets_link_local", []string{"localnets"}, "fe80::1", "", false},
+		{"negated_subnet", []string{"office"}, "192.168.1.200", "", false},
+		{"negated_subnet_next_list", []string{"office", "any"}, "192.168.1.200", "", false},
+		{"office_subnet", []string{"office"}, "192.168.1.10", "", true},
+		{"tsig_key", []string{"office"}, "8.8.8.8", "office.", true},
+		{"nested_lists", []string{"trusted"}, "100.64.0.1", "", true},
+		{"nested_ipv6", []string{"trusted"}, "2001:db8::1", "", true},
+		{"none", []string{"none"}, "127.0.0.1", "", false},
+		{"unknown_list", []string{"missing"}, "127.0.0.1", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := acl.Allowed(tt.lists, net.ParseIP(tt.ip), tt.key); got != tt.want {
+				t.Errorf("Allowed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestACL_Set(t *testing.T) {
+	acl := NewACL()
+	tests := []struct {
+		name    string
+		def     string
+		wantErr bool
+	}{
+		{"valid", "lan=10.0.0.0/8", false},
+		{"built_in", "any=10.0.0.0/8", true},
+		{"invalid_cidr", "lan=10.0.0.0/33", true},
+		{"empty_name", "=10.0.0.0/8", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md, err := ParseACL(tt.def)
+			if err != nil {
+				t.Fatalf("ParseACL() error = %v", err)
+			}
+			if err = acl.Set(md); (err != nil) != tt.wantErr {
+				t.Errorf("Set() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestACL_Check(t *testing.T) {
+	acl := NewACL()
+	if err := acl.Set(&models.ACL{Name: "vpn", Elements: []string{"100.64.0.0/10"}}); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	if err := acl.Check([]string{"localnets", "VPN", "none"}); err != nil {
+		t.Errorf("Check() error = %v", err)
+	}
+	if err := acl.Check([]string{"localnets", "office"}); !errors.Is(err, errACLUnknown) {
+		t.Errorf("Check() of unknown list error = %v", err)
+	}
+}
@@ -0,0 +1,125 @@
+package dns
+
+import (
+	"net"
+	"strings"
+
+	"github.com/MarlikAlmighty/mdns/internal/data"
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	"github.com/miekg/dns"
+)
+
+// srv answer SRV records of service with name of query, nodata without them
+func (s *DNS) srv(msg *dns.Msg, entry *models.DNSEntry) {
+
+	name := strings.ToLower(msg.Question[0].Name)
+	for _, v := range entry.Srvs {
+		if v == nil || owner(entry.Domain, v.Name) != name {
+			continue
+		}
+		msg.Answer = append(msg.Answer,
+			&dns.SRV{
+				Hdr: dns.RR_Header{
+					Name:   msg.Question[0].Name,
+					Rrtype: dns.TypeSRV,
+					Class:  dns.ClassINET,
+					Ttl:    600,
+				},
+				Priority: uint16(v.Priority),
+				Weight:   uint16(v.Weight),
+				Port:     uint16(v.Port),
+				Target:   owner(entry.Domain, v.Target),
+			})
+	}
+
+	if len(msg.Answer) == 0 {
+		s.nodata(msg, entry)
+	}
+}
+
+// minimal answers without additional records which are not needed, referrals keep their glue
+func (s *DNS) minimal() bool {
+	return s.Config != nil && s.Config.MinimalResponses
+}
+
+// additional put addresses of targets of MX, NS and SRV records into additional section, only for
+// targets in own zones which are not delegated; records which do not fit into reply are dropped
+func (s *DNS) additional(msg *dns.Msg, entry *models.DNSEntry, subnet *net.IPNet, zones *data.ResolvedData) {
+
+	if s.minimal() {
+		return
+	}
+
+	seen := make(map[string]bool)
+	for _, rr := range msg.Extra {
+		seen[strings.ToLower(rr.Header().Name)] = true
+	}
+
+	for _, rr := range msg.Answer {
+
+		var target string
+		switch v := rr.(type) {
+		case *dns.MX:
+			target = v.Mx
+		case *dns.NS:
+			target = v.Ns
+		case *dns.SRV:
+			target = v.Target
+		default:
+			continue
+		}
+
+		target = strings.ToLower(target)
+		if target == "." || seen[target] {
+			continue
+		}
+		seen[target] = true
+
+		msg.Extra = append(msg.Extra, s.targetAddresses(entry, target, subnet, zones)...)
+	}
+}
+
+// targetAddresses A and AAAA records of target from its own zone, which is the closest zone of target or
+// zone of answer without zones; nothing for targets which are not ours or delegated. Rotation of balanced
+// addresses does not move.
+func (s *DNS) targetAddresses(entry *models.DNSEntry, target string, subnet *net.IPNet, zones *data.ResolvedData) []dns.RR {
+
+	if zones != nil {
+		entry = closestZone(zones, target)
+	}
+	if entry.Domain == "" || isReverse(entry.Domain) || !dns.IsSubDomain(strings.ToLower(entry.Domain), target) {
+		return nil
+	}
+	if d, _ := delegated(entry, target); d != nil {
+		return nil
+	}
+
+	// name servers and policy host of MTA-STS have own addresses
+	ns := hostAddresses(entry, target)
+	if ns == nil {
+		ns = s.MTASTS.host(entry, target)
+	}
+
+	var ipv4s, ipv6s []string
+	if ns != nil {
+		ipv4s, ipv6s = ns.Ipv4s, ns.Ipv6s
+	} else {
+		ipv4s, ipv6s = s.addresses(entry, subnet)
+		ipv4s, ipv6s = s.Balancer.Peek(entry, "ipv4", ipv4s), s.Balancer.Peek(entry, "ipv6", ipv6s)
+	}
+
+	var rrs []dns.RR
+	for _, ip := range ipv4s {
+		rrs = append(rrs, &dns.A{
+			Hdr: dns.RR_Header{Name: target, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60},
+			A:   net.ParseIP(ip),
+		})
+	}
+	for _, ip := range ipv6s {
+		rrs = append(rrs, &dns.AAAA{
+			Hdr:  dns.RR_Header{Name: target, Rrtype: dns.TypeAAAA, Class: dns.ClassINET, Ttl: 60},
+			AAAA: net.ParseIP(ip),
+		})
+	}
+	return rrs
+}
@@ -0,0 +1,145 @@
+package dns
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/MarlikAlmighty/mdns/internal/config"
+	"github.com/MarlikAlmighty/mdns/internal/data"
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	"github.com/miekg/dns"
+)
+
+func TestAdditional(t *testing.T) {
+
+	s := &DNS{Config: &config.Configuration{}}
+	entry := &models.DNSEntry{
+		Domain: "example.com.",
+		Ipv4s:  []string{"192.0.2.1"},
+		Ipv6s:  []string{"2001:db8::1"},
+		Srvs: []*models.SrvRecord{
+			{Name: "_sip._tcp", Priority: 10, Weight: 5, Port: 5060, Target: "sip"},
+			{Name: "_sip._tcp", Priority: 20, Port: 5060, Target: "sip.example.net."},
+			{Name: "_xmpp._tcp", Target: "chat.lab"},
+		},
+		Delegations: []*models.Delegation{
+			{Name: "lab", NameServers: []*models.NameServer{{Name: "ns.example.net."}}},
+		},
+	}
+
+	query := func(name string, qtype uint16) *dns.Msg {
+		msg := &dns.Msg{}
+		msg.SetQuestion(name, qtype)
+		s.answer(msg, entry, nil, nil)
+		return msg
+	}
+
+	// addresses of target in zone
+	msg := query("example.com.", dns.TypeMX)
+	if len(msg.Answer) != 1 || len(msg.Extra) != 2 || msg.Extra[0].Header().Name != "mail.example.com." {
+		t.Errorf("unexpected MX answer %v", msg)
+	}
+
+	// NS targets in zone
+	msg = query("example.com.", dns.TypeNS)
+	if len(msg.Extra) != 4 {
+		t.Errorf("expected A and AAAA of ns1 and ns2, got %v", msg.Extra)
+	}
+
+	// SRV records of service, only the target in zone gets addresses
+	msg = query("_sip._tcp.example.com.", dns.TypeSRV)
+	if len(msg.Answer) != 2 || len(msg.Extra) != 2 {
+		t.Fatalf("unexpected SRV answer %v", msg)
+	}
+	if v := msg.Answer[0].(*dns.SRV); v.Target != "sip.example.com." || v.Port != 5060 || v.Priority != 10 || v.Weight != 5 {
+		t.Errorf("unexpected SRV record %v", v)
+	}
+
+	// targets in delegated zones are not ours
+	msg = query("_xmpp._tcp.example.com.", dns.TypeSRV)
+	if len(msg.Answer) != 1 || len(msg.Extra) != 0 {
+		t.Errorf("unexpected SRV answer with delegated target %v", msg)
+	}
+
+	// service without records
+	msg = query("_ldap._tcp.example.com.", dns.TypeSRV)
+	if len(msg.Answer) != 0 || len(msg.Ns) != 1 {
+		t.Errorf("expected nodata, got %v", msg)
+	}
+
+	s.Config.MinimalResponses = true
+	if msg = query("example.com.", dns.TypeMX); len(msg.Extra) != 0 {
+		t.Errorf("minimal response with additional records %v", msg.Extra)
+	}
+}
+
+func TestAdditionalOwnZone(t *testing.T) {
+
+	zones := data.New()
+	zones.Set("example.com.", &models.DNSEntry{
+		Domain:  "example.com.",
+		Ipv4s:   []string{"192.0.2.1", "192.0.2.2"},
+		Balance: &models.Balance{Policy: policyRoundRobin},
+	})
+	zones.Set("mail.example.com.", &models.DNSEntry{Domain: "mail.example.com.", Ipv4s: []string{"198.51.100.25"}})
+	s := &DNS{Config: &config.Configuration{}, Balancer: NewBalancer()}
+
+	query := func(name string, qtype uint16) *dns.Msg {
+		msg := &dns.Msg{}
+		msg.SetQuestion(name, qtype)
+		s.answer(msg, closestZone(zones, name), nil, zones)
+		return msg
+	}
+
+	// target with own zone gets its addresses, not those of apex
+	msg := query("example.com.", dns.TypeMX)
+	if len(msg.Extra) != 1 || msg.Extra[0].(*dns.A).A.String() != "198.51.100.25" {
+		t.Errorf("additional of MX = %v, want A of mail.example.com.", msg.Extra)
+	}
+	if a := query("mail.example.com.", dns.TypeA); len(a.Answer) != 1 || a.Answer[0].(*dns.A).A.String() != "198.51.100.25" {
+		t.Errorf("A of mail.example.com. = %v", a.Answer)
+	}
+
+	// additional records of NS targets do not move rotation
+	for i := 0; i < 3; i++ {
+		if msg = query("example.com.", dns.TypeNS); len(msg.Extra) != 4 || msg.Extra[0].(*dns.A).A.String() != "192.0.2.1" {
+			t.Fatalf("additional of NS = %v", msg.Extra)
+		}
+	}
+	for _, want := range []string{"192.0.2.1", "192.0.2.2", "192.0.2.1"} {
+		if a := query("example.com.", dns.TypeA); a.Answer[0].(*dns.A).A.String() != want {
+			t.Errorf("first A = %v, want %v", a.Answer[0], want)
+		}
+	}
+}
+
+func TestAdditionalTruncation(t *testing.T) {
+
+	s := &DNS{Config: &config.Configuration{EdnsUdpSize: 1232}}
+
+	r := &dns.Msg{}
+	r.SetQuestion("example.com.", dns.TypeMX)
+
+	msg := &dns.Msg{}
+	msg.SetReply(r)
+	msg.Answer = append(msg.Answer, &dns.MX{
+		Hdr: dns.RR_Header{Name: "example.com.", Rrtype: dns.TypeMX, Class: dns.ClassINET, Ttl: 600},
+		Mx:  "mail.example.com.",
+	})
+	for i := 0; i < 50; i++ {
+		msg.Extra = append(msg.Extra, &dns.A{
+			Hdr: dns.RR_Header{Name: "mail.example.com.", Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60},
+			A:   net.ParseIP(fmt.Sprintf("192.0.2.%d", i)),
+		})
+	}
+
+	// additional records which do not fit are dropped without truncation
+	s.edns(r, msg, true, nil)
+	if msg.Truncated || len(msg.Answer) != 1 || len(msg.Extra) == 0 || len(msg.Extra) == 50 {
+		t.Errorf("TC = %v, answers = %v, additional = %v", msg.Truncated, len(msg.Answer), len(msg.Extra))
+	}
+	if l := msg.Len(); l > dns.MinMsgSize {
+		t.Errorf("length = %v", l)
+	}
+}
@@ -0,0 +1,132 @@
+package dns
+
+import (
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+// policies of selecting answered addresses, all addresses in stored order otherwise
+const (
+	policyRoundRobin   = "round_robin"
+	policyWeighted     = "weighted"
+	policyFirstHealthy = "first_healthy"
+)
+
+// Balancer selection of answered addresses by policy of dns entry
+type Balancer struct {
+	counters map[string]int
+	rand     *rand.Rand
+	mux      sync.Mutex
+}
+
+// NewBalancer simple constructor
+func NewBalancer() *Balancer {
+	return &Balancer{
+		counters: make(map[string]int),
+		rand:     rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// Select addresses of entry to answer, addrs are healthy addresses in stored order
+func (b *Balancer) Select(entry *models.DNSEntry, family string, addrs []string) []string {
+
+	if b == nil || entry.Balance == nil || len(addrs) < 2 {
+		return addrs
+	}
+
+	switch entry.Balance.Policy {
+	case policyFirstHealthy:
+		return addrs[:1]
+	case policyRoundRobin:
+		return b.rotate(strings.ToLower(entry.Domain)+"|"+family, addrs)
+	case policyWeighted:
+		return b.weighted(entry.Balance, addrs)
+	}
+
+	return addrs
+}
+
+// Peek addresses of entry as answered now without moving rotation, for records of additional section;
+// weighted entries give all addresses
+func (b *Balancer) Peek(entry *models.DNSEntry, family string, addrs []string) []string {
+
+	if b == nil || entry.Balance == nil || len(addrs) < 2 {
+		return addrs
+	}
+
+	switch entry.Balance.Policy {
+	case policyFirstHealthy:
+		return addrs[:1]
+	case policyRoundRobin:
+		b.mux.Lock()
+		n := b.counters[strings.ToLower(entry.Domain)+"|"+family] % len(addrs)
+		b.mux.Unlock()
+		return append(append([]string{}, addrs[n:]...), addrs[:n]...)
+	}
+
+	return addrs
+}
+
+// rotate addresses by one position on every answer
+func (b *Balancer) rotate(key string, addrs []string) []string {
+
+	b.mux.Lock()
+	n := b.counters[key] % len(addrs)
+	b.counters[key] = n + 1
+	b.mux.Unlock()
+
+	return append(append([]string{}, addrs[n:]...), addrs[:n]...)
+}
+
+// weighted random subset of count addresses without repetition, drained addresses only when all are drained
+func (b *Balancer) weighted(md *models.Balance, addrs []string) []string {
+
+	count := int(md.Count)
+	if count <= 0 {
+		count = 1
+	}
+
+	weights := make([]int64, len(addrs))
+	var total int64
+	for i, a := range addrs {
+		weights[i] = 1
+		if w, ok := md.Weights[a]; ok {
+			weights[i] = w
+		}
+		if weights[i] < 0 {
+			weights[i] = 0
+		}
+		total += weights[i]
+	}
+
+	// every address is drained
+	if total == 0 {
+		for i := range weights {
+			weights[i] = 1
+		}
+		total = int64(len(weights))
+	}
+
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	var picked []string
+	for len(picked) < count && total > 0 {
+		n := b.rand.Int63n(total)
+		for i, w := range weights {
+			if n < w {
+				picked = append(picked, addrs[i])
+				total -= w
+				weights[i] = 0
+				break
+			}
+			n -= w
+		}
+	}
+
+	return picked
+}
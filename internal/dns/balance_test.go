@@ -0,0 +1,94 @@
+package dns
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+func TestBalancer_Select(t *testing.T) {
+
+	addrs := []string{"192.0.2.1", "192.0.2.2", "192.0.2.3"}
+	entry := func(md *models.Balance) *models.DNSEntry {
+		return &models.DNSEntry{Domain: "example.com.", Balance: md}
+	}
+
+	b := NewBalancer()
+
+	// stored order without policy
+	if got := b.Select(entry(nil), "ipv4", addrs); !reflect.DeepEqual(got, addrs) {
+		t.Errorf("Select() without policy = %v", got)
+	}
+	if got := b.Select(entry(&models.Balance{Policy: "all"}), "ipv4", addrs); !reflect.DeepEqual(got, addrs) {
+		t.Errorf("Select() all = %v", got)
+	}
+
+	// first healthy
+	if got := b.Select(entry(&models.Balance{Policy: policyFirstHealthy}), "ipv4", addrs[1:]); !reflect.DeepEqual(got, []string{"192.0.2.2"}) {
+		t.Errorf("Select() first healthy = %v", got)
+	}
+
+	// round robin rotates per domain and family
+	rr := entry(&models.Balance{Policy: policyRoundRobin})
+	for _, want := range [][]string{
+		{"192.0.2.1", "192.0.2.2", "192.0.2.3"},
+		{"192.0.2.2", "192.0.2.3", "192.0.2.1"},
+		{"192.0.2.3", "192.0.2.1", "192.0.2.2"},
+		{"192.0.2.1", "192.0.2.2", "192.0.2.3"},
+	} {
+		if got := b.Select(rr, "ipv4", addrs); !reflect.DeepEqual(got, want) {
+			t.Errorf("Select() round robin = %v, want %v", got, want)
+		}
+	}
+	if got := b.Select(rr, "ipv6", addrs); got[0] != "192.0.2.1" {
+		t.Errorf("Select() round robin of other family = %v", got)
+	}
+	if got := b.Select(rr, "ipv4", addrs); len(got) != len(addrs) {
+		t.Errorf("Select() round robin answers %v addresses", len(got))
+	}
+}
+
+func TestBalancer_weighted(t *testing.T) {
+
+	b := NewBalancer()
+	b.rand = rand.New(rand.NewSource(1))
+
+	addrs := []string{"192.0.2.1", "192.0.2.2", "192.0.2.3"}
+	md := &models.Balance{
+		Policy:  policyWeighted,
+		Weights: map[string]int64{"192.0.2.1": 3, "192.0.2.2": 1, "192.0.2.3": 0},
+	}
+	entry := &models.DNSEntry{Domain: "example.com.", Balance: md}
+
+	// drained address is never picked, others by their weights
+	hits := make(map[string]int)
+	for i := 0; i < 4000; i++ {
+		got := b.Select(entry, "ipv4", addrs)
+		if len(got) != 1 {
+			t.Fatalf("Select() = %v", got)
+		}
+		hits[got[0]]++
+	}
+	if hits["192.0.2.3"] != 0 {
+		t.Errorf("drained address picked %v times", hits["192.0.2.3"])
+	}
+	if hits["192.0.2.1"] < 2800 || hits["192.0.2.1"] > 3200 {
+		t.Errorf("hits = %v, want about 3000 of 192.0.2.1", hits)
+	}
+
+	// subset of distinct addresses without drained ones
+	md.Count = 3
+	got := b.Select(entry, "ipv4", addrs)
+	if len(got) != 2 || got[0] == got[1] {
+		t.Errorf("Select() count 3 = %v", got)
+	}
+
+	// every address drained
+	md.Count = 1
+	md.Weights = map[string]int64{"192.0.2.1": 0, "192.0.2.2": 0, "192.0.2.3": 0}
+	if got = b.Select(entry, "ipv4", addrs); len(got) != 1 {
+		t.Errorf("Select() all drained = %v", got)
+	}
+}
@@ -0,0 +1,413 @@
+package dns
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	"github.com/miekg/dns"
+)
+
+// formats of block lists
+const (
+	formatHosts   = "hosts"
+	formatDomains = "domains"
+	formatAdblock = "adblock"
+)
+
+// localNames usual entries of hosts files which are not blocked
+var localNames = map[string]bool{
+	"localhost.":             true,
+	"localhost.localdomain.": true,
+	"local.":                 true,
+	"broadcasthost.":         true,
+	"ip6-localhost.":         true,
+	"ip6-loopback.":          true,
+}
+
+var (
+	errBlocklistName    = errors.New("blocklist: empty name")
+	errBlocklistSource  = errors.New("blocklist: empty source")
+	errBlocklistUnknown = errors.New("blocklist: list does not exist")
+)
+
+// list loaded block or allow list, value of names and exceptions shows that subdomains are matched too
+type list struct {
+	md      models.Blocklist
+	names   map[string]bool
+	except  map[string]bool
+	updated time.Time
+	hits    atomic.Int64
+}
+
+// Blocklist block and allow lists of domains for forwarded queries
+type Blocklist struct {
+	Client *http.Client
+	lists  map[string]*list
+	// lists by order and name, so hits count for the same list every time
+	sorted []*list
+	mux    sync.RWMutex
+}
+
+// NewBlocklist simple constructor
+func NewBlocklist() *Blocklist {
+	return &Blocklist{
+		Client: &http.Client{Timeout: 30 * time.Second},
+		lists:  make(map[string]*list),
+	}
+}
+
+// ParseBlocklist parse definition like "name=format source [group ...]", allow lists have "+" before name
+func ParseBlocklist(def string) (*models.Blocklist, error) {
+	kv := strings.SplitN(def, "=", 2)
+	if len(kv) != 2 {
+		return nil, fmt.Errorf("blocklist: invalid definition %q", def)
+	}
+	fields := strings.Fields(kv[1])
+	if len(fields) < 2 {
+		return nil, fmt.Errorf("blocklist: invalid definition %q", def)
+	}
+	name := strings.TrimSpace(kv[0])
+	return &models.Blocklist{
+		Name:   strings.TrimPrefix(name, "+"),
+		Allow:  strings.HasPrefix(name, "+"),
+		Format: fields[0],
+		Source: fields[1],
+		Groups: fields[2:],
+	}, nil
+}
+
+// Set load list from source and save it
+func (b *Blocklist) Set(ctx context.Context, md *models.Blocklist) error {
+
+	if md.Name == "" {
+		return errBlocklistName
+	}
+
+	if md.Source == "" {
+		return errBlocklistSource
+	}
+
+	l := newList(md)
+	if err := b.load(ctx, l); err != nil {
+		return err
+	}
+
+	b.store(l)
+	return nil
+}
+
+// store save list, hits survive replacing of list
+func (b *Blocklist) store(l *list) {
+	b.mux.Lock()
+	if old, ok := b.lists[l.md.Name]; ok {
+		l.hits.Store(old.hits.Load())
+	}
+	b.lists[l.md.Name] = l
+	b.sort()
+	b.mux.Unlock()
+}
+
+// sort lists by order and name, caller holds lock
+func (b *Blocklist) sort() {
+	b.sorted = b.sorted[:0]
+	for _, l := range b.lists {
+		b.sorted = append(b.sorted, l)
+	}
+	sort.Slice(b.sorted, func(i, j int) bool {
+		if b.sorted[i].md.Order != b.sorted[j].md.Order {
+			return b.sorted[i].md.Order < b.sorted[j].md.Order
+		}
+		return b.sorted[i].md.Name < b.sorted[j].md.Name
+	})
+}
+
+// Get fetch list by name
+func (b *Blocklist) Get(name string) *models.Blocklist {
+	b.mux.RLock()
+	defer b.mux.RUnlock()
+	if l, ok := b.lists[name]; ok {
+		return l.model()
+	}
+	return &models.Blocklist{}
+}
+
+// Delete list by name
+func (b *Blocklist) Delete(name string) error {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	if _, ok := b.lists[name]; !ok {
+		return errBlocklistUnknown
+	}
+	delete(b.lists, name)
+	b.sort()
+	return nil
+}
+
+// GetMap get all lists
+func (b *Blocklist) GetMap() map[string]models.Blocklist {
+	b.mux.RLock()
+	mp := make(map[string]models.Blocklist, len(b.lists))
+	for k, v := range b.lists {
+		mp[k] = *v.model()
+	}
+	b.mux.RUnlock()
+	return mp
+}
+
+// Blocked check name against lists which apply to client, allow lists win,
+// hit counts for first matching block list in order
+func (b *Blocklist) Blocked(name string, applies func(groups []string) bool) bool {
+
+	name = strings.ToLower(dns.Fqdn(name))
+
+	b.mux.RLock()
+	defer b.mux.RUnlock()
+
+	var blocked *list
+	for _, l := range b.sorted {
+		if len(l.md.Groups) > 0 && !applies(l.md.Groups) {
+			continue
+		}
+		if !l.match(name) {
+			continue
+		}
+		if l.md.Allow {
+			return false
+		}
+		if blocked == nil {
+			blocked = l
+		}
+	}
+
+	if blocked == nil {
+		return false
+	}
+
+	blocked.hits.Add(1)
+	return true
+}
+
+// Refresh reload all lists from their sources every interval until context is done
+func (b *Blocklist) Refresh(ctx context.Context, interval time.Duration) {
+
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, md := range b.GetMap() {
+				l := newList(&md)
+				if err := b.load(ctx, l); err != nil {
+					log.Printf("[ERR]: refresh blocklist %v: %v\n", md.Name, err)
+					continue
+				}
+				b.mux.Lock()
+				// list could be deleted or replaced while loading
+				if old, ok := b.lists[md.Name]; ok && old.md.Source == l.md.Source {
+					l.hits.Store(old.hits.Load())
+					b.lists[md.Name] = l
+					b.sort()
+				}
+				b.mux.Unlock()
+			}
+		}
+	}
+}
+
+// load read and parse source of list
+func (b *Blocklist) load(ctx context.Context, l *list) error {
+
+	var rc io.ReadCloser
+
+	if strings.HasPrefix(l.md.Source, "http://") || strings.HasPrefix(l.md.Source, "https://") {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, l.md.Source, nil)
+		if err != nil {
+			return err
+		}
+		resp, err := b.Client.Do(req)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode != http.StatusOK {
+			_ = resp.Body.Close()
+			return fmt.Errorf("blocklist: fetch %v: %v", l.md.Source, resp.Status)
+		}
+		rc = resp.Body
+	} else {
+		f, err := os.Open(l.md.Source)
+		if err != nil {
+			return err
+		}
+		rc = f
+	}
+
+	defer func() {
+		if err := rc.Close(); err != nil {
+			log.Printf("[ERR]: close blocklist %v: %v\n", l.md.Source, err)
+		}
+	}()
+
+	names, except, err := parse(rc, l.md.Format)
+	if err != nil {
+		return err
+	}
+
+	l.names, l.except, l.updated = names, except, time.Now()
+	return nil
+}
+
+// newList list with settings of model
+func newList(md *models.Blocklist) *list {
+	return &list{
+		md: models.Blocklist{
+			Name:   md.Name,
+			Order:  md.Order,
+			Source: md.Source,
+			Format: md.Format,
+			Allow:  md.Allow,
+			Groups: md.Groups,
+		},
+	}
+}
+
+// match name or one of its parents matched with subdomains, unless an exception covers name the same way
+func (l *list) match(name string) bool {
+	return covers(l.names, name) && !covers(l.except, name)
+}
+
+// covers name is in set or one of its parents is in set with subdomains
+func covers(set map[string]bool, name string) bool {
+	if _, ok := set[name]; ok {
+		return true
+	}
+	for off, end := dns.NextLabel(name, 0); !end; off, end = dns.NextLabel(name, off) {
+		if set[name[off:]] {
+			return true
+		}
+	}
+	return false
+}
+
+// model list with counters
+func (l *list) model() *models.Blocklist {
+	md := l.md
+	md.Entries = int64(len(l.names))
+	md.Hits = l.hits.Load()
+	md.Updated = l.updated.Format(time.RFC3339)
+	return &md
+}
+
+// parse lines of list, hosts and domains match exactly, adblock rules, their exceptions and "*." match subdomains
+func parse(r io.Reader, format string) (map[string]bool, map[string]bool, error) {
+
+	names := make(map[string]bool)
+	except := make(map[string]bool)
+
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "!") {
+			continue
+		}
+
+		switch format {
+		case formatHosts:
+			fields := strings.Fields(line)
+			if len(fields) < 2 || net.ParseIP(fields[0]) == nil {
+				continue
+			}
+			for _, v := range fields[1:] {
+				if strings.HasPrefix(v, "#") {
+					break
+				}
+				if v = strings.ToLower(dns.Fqdn(v)); !localNames[v] {
+					names[v] = false
+				}
+			}
+		case formatDomains, "":
+			line = strings.Fields(line)[0]
+			sub := strings.HasPrefix(line, "*.")
+			names[strings.ToLower(dns.Fqdn(strings.TrimPrefix(line, "*.")))] = sub
+		case formatAdblock:
+			exception := strings.HasPrefix(line, "@@")
+			line = strings.TrimPrefix(line, "@@")
+			// only rules for whole domains like ||example.com^
+			if !strings.HasPrefix(line, "||") || !strings.HasSuffix(line, "^") {
+				continue
+			}
+			name := strings.ToLower(dns.Fqdn(strings.TrimSuffix(strings.TrimPrefix(line, "||"), "^")))
+			if exception {
+				except[name] = true
+			} else {
+				names[name] = true
+			}
+		default:
+			return nil, nil, fmt.Errorf("blocklist: unknown format %q", format)
+		}
+	}
+
+	return names, except, sc.Err()
+}
+
+// block build answer for blocked name: nxdomain, null addresses or custom addresses
+func block(r *dns.Msg, response []string) *dns.Msg {
+
+	msg := &dns.Msg{}
+	msg.SetReply(r)
+	msg.RecursionAvailable = true
+
+	var ips []net.IP
+	for _, v := range response {
+		switch v = strings.ToLower(strings.TrimSpace(v)); v {
+		case "nxdomain":
+			msg.Rcode = dns.RcodeNameError
+			return msg
+		case "null":
+			ips = append(ips, net.IPv4zero, net.IPv6zero)
+		default:
+			if ip := net.ParseIP(v); ip != nil {
+				ips = append(ips, ip)
+			}
+		}
+	}
+
+	q := r.Question[0]
+	header := dns.RR_Header{
+		Name:   q.Name,
+		Rrtype: q.Qtype,
+		Class:  dns.ClassINET,
+		Ttl:    60,
+	}
+
+	for _, ip := range ips {
+		switch {
+		case q.Qtype == dns.TypeA && ip.To4() != nil:
+			msg.Answer = append(msg.Answer, &dns.A{Hdr: header, A: ip})
+		case q.Qtype == dns.TypeAAAA && ip.To4() == nil:
+			msg.Answer = append(msg.Answer, &dns.AAAA{Hdr: header, AAAA: ip})
+		}
+	}
+
+	return msg
+}
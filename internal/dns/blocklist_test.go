@@ -0,0 +1,155 @@
+package dns
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	"github.com/miekg/dns"
+)
+
+func TestBlocklist_Blocked(t *testing.T) {
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"hosts":   "# comment\n0.0.0.0 localhost\n0.0.0.0 ads.example.com tracker.example.com # inline\n",
+		"domains": "exact.example.net\n*.wild.example.net\n",
+		"adblock": "! title\n||doubleclick.example^\n@@||ok.doubleclick.example^\n||path.example/ads\n",
+		"allow":   "tracker.example.com\n",
+		"kids":    "games.example.org\n",
+	}
+	for k, v := range files {
+		if err := os.WriteFile(filepath.Join(dir, k), []byte(v), 0o600); err != nil {
+			t.Fatalf("write %v: %v", k, err)
+		}
+	}
+
+	acl := NewACL()
+	if err := acl.Set(&models.ACL{Name: "kids", Elements: []string{"10.0.0.0/24"}}); err != nil {
+		t.Fatalf("acl: %v", err)
+	}
+
+	b := NewBlocklist()
+	for _, md := range []*models.Blocklist{
+		{Name: "hosts", Format: formatHosts, Source: filepath.Join(dir, "hosts")},
+		{Name: "domains", Format: formatDomains, Source: filepath.Join(dir, "domains")},
+		{Name: "adblock", Format: formatAdblock, Source: filepath.Join(dir, "adblock")},
+		{Name: "allow", Format: formatDomains, Source: filepath.Join(dir, "allow"), Allow: true},
+		{Name: "kids", Format: formatDomains, Source: filepath.Join(dir, "kids"), Groups: []string{"kids"}},
+	} {
+		if err := b.Set(context.Background(), md); err != nil {
+			t.Fatalf("Set(%v) error = %v", md.Name, err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		domain string
+		client string
+		want   bool
+	}{
+		{"hosts_exact", "ads.example.com.", "192.168.0.1", true},
+		{"hosts_subdomain_not_blocked", "x.ads.example.com.", "192.168.0.1", false},
+		{"hosts_localhost_skipped", "localhost.", "192.168.0.1", false},
+		{"allow_list_wins", "tracker.example.com.", "192.168.0.1", false},
+		{"domains_exact", "EXACT.example.net", "192.168.0.1", true},
+		{"domains_wildcard", "a.b.wild.example.net.", "192.168.0.1", true},
+		{"adblock_subdomain", "x.doubleclick.example.", "192.168.0.1", true},
+		{"adblock_exception", "ok.doubleclick.example.", "192.168.0.1", false},
+		{"adblock_exception_subdomain", "a.cdn.ok.doubleclick.example.", "192.168.0.1", false},
+		{"adblock_exception_parent_blocked", "doubleclick.example.", "192.168.0.1", true},
+		{"adblock_path_rule_ignored", "path.example.", "192.168.0.1", false},
+		{"group_applies", "games.example.org.", "10.0.0.5", true},
+		{"group_not_applies", "games.example.org.", "10.0.1.5", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			applies := func(groups []string) bool {
+				return acl.Allowed(groups, net.ParseIP(tt.client), "")
+			}
+			if got := b.Blocked(tt.domain, applies); got != tt.want {
+				t.Errorf("Blocked() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if hits := b.Get("hosts").Hits; hits != 1 {
+		t.Errorf("hits = %v, want 1", hits)
+	}
+}
+
+func TestBlock(t *testing.T) {
+	tests := []struct {
+		name     string
+		qtype    uint16
+		response []string
+		rcode    int
+		answer   string
+	}{
+		{"nxdomain", dns.TypeA, []string{"nxdomain"}, dns.RcodeNameError, ""},
+		{"null_a", dns.TypeA, []string{"null"}, dns.RcodeSuccess, "0.0.0.0"},
+		{"null_aaaa", dns.TypeAAAA, []string{"null"}, dns.RcodeSuccess, "::"},
+		{"custom_ip", dns.TypeA, []string{"192.168.0.10", "fd00::10"}, dns.RcodeSuccess, "192.168.0.10"},
+		{"custom_ip_other_type", dns.TypeMX, []string{"192.168.0.10"}, dns.RcodeSuccess, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &dns.Msg{}
+			r.SetQuestion("ads.example.com.", tt.qtype)
+			msg := block(r, tt.response)
+			if msg.Rcode != tt.rcode {
+				t.Errorf("block() rcode = %v, want %v", msg.Rcode, tt.rcode)
+			}
+			var answer string
+			if len(msg.Answer) > 0 {
+				switch v := msg.Answer[0].(type) {
+				case *dns.A:
+					answer = v.A.String()
+				case *dns.AAAA:
+					answer = v.AAAA.String()
+				}
+			}
+			if answer != tt.answer || len(msg.Answer) > 1 {
+				t.Errorf("block() answer = %v, want %v", msg.Answer, tt.answer)
+			}
+		})
+	}
+}
+
+func TestBlocklist_Order(t *testing.T) {
+
+	source := filepath.Join(t.TempDir(), "ads")
+	if err := os.WriteFile(source, []byte("ads.example.com\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	b := NewBlocklist()
+	for _, md := range []*models.Blocklist{
+		{Name: "a", Order: 2, Format: formatDomains, Source: source},
+		{Name: "b", Order: 1, Format: formatDomains, Source: source},
+		{Name: "c", Order: 1, Format: formatDomains, Source: source},
+	} {
+		if err := b.Set(context.Background(), md); err != nil {
+			t.Fatalf("Set(%v) error = %v", md.Name, err)
+		}
+	}
+
+	// hits count for first list by order and name
+	for i := 0; i < 20; i++ {
+		b.Blocked("ads.example.com.", func([]string) bool { return true })
+	}
+	if got := []int64{b.Get("a").Hits, b.Get("b").Hits, b.Get("c").Hits}; got[0] != 0 || got[1] != 20 || got[2] != 0 {
+		t.Errorf("hits = %v, want [0 20 0]", got)
+	}
+
+	if err := b.Delete("b"); err != nil {
+		t.Fatal(err)
+	}
+	b.Blocked("ads.example.com.", func([]string) bool { return true })
+	if got := b.Get("c").Hits; got != 1 {
+		t.Errorf("hits after delete = %v, want 1", got)
+	}
+}
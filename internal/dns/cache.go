@@ -0,0 +1,193 @@
+package dns
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// cacheKey question of cached answers, answers with DNSSEC records are kept apart
+type cacheKey struct {
+	name   string
+	qtype  uint16
+	qclass uint16
+	do     bool
+}
+
+// cacheEntry answer of upstream for network of scope, nil network for everybody
+type cacheEntry struct {
+	network *net.IPNet
+	scope   uint8
+	msg     *dns.Msg
+	stored  time.Time
+	expire  time.Time
+}
+
+// Cache answers of upstream, keyed by question and network of EDNS client subnet scope
+type Cache struct {
+	size    int
+	count   int
+	entries map[cacheKey][]*cacheEntry
+	now     func() time.Time
+	mux     sync.Mutex
+}
+
+// NewCache simple constructor, zero size disables cache
+func NewCache(size int) *Cache {
+	return &Cache{
+		size:    size,
+		entries: make(map[cacheKey][]*cacheEntry),
+		now:     time.Now,
+	}
+}
+
+// key of query
+func (c *Cache) key(r *dns.Msg) cacheKey {
+	q := r.Question[0]
+	k := cacheKey{name: strings.ToLower(q.Name), qtype: q.Qtype, qclass: q.Qclass}
+	if opt := r.IsEdns0(); opt != nil {
+		k.do = opt.Do()
+	}
+	return k
+}
+
+// Get answer for query from client subnet with remaining ttl and its scope,
+// the most specific network containing client wins
+func (c *Cache) Get(r *dns.Msg, subnet *net.IPNet) (*dns.Msg, uint8, bool) {
+
+	if c.size <= 0 || len(r.Question) != 1 {
+		return nil, 0, false
+	}
+
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	now := c.now()
+	var found *cacheEntry
+	for _, e := range c.entries[c.key(r)] {
+		if !now.Before(e.expire) {
+			continue
+		}
+		if e.network != nil && (subnet == nil || !e.network.Contains(subnet.IP)) {
+			continue
+		}
+		if found == nil || e.scope > found.scope {
+			found = e
+		}
+	}
+	if found == nil {
+		return nil, 0, false
+	}
+
+	msg := found.msg.Copy()
+	msg.Id = r.Id
+	age := uint32(now.Sub(found.stored) / time.Second)
+	for _, rrs := range [][]dns.RR{msg.Answer, msg.Ns, msg.Extra} {
+		for _, rr := range rrs {
+			if rr.Header().Rrtype != dns.TypeOPT {
+				rr.Header().Ttl -= age
+			}
+		}
+	}
+	return msg, found.scope, true
+}
+
+// Set store answer for query from client subnet, valid for network of scope;
+// only successful and negative answers with ttl are cached
+func (c *Cache) Set(r, msg *dns.Msg, subnet *net.IPNet, scope uint8) {
+
+	if c.size <= 0 || len(r.Question) != 1 || msg.Truncated ||
+		(msg.Rcode != dns.RcodeSuccess && msg.Rcode != dns.RcodeNameError) {
+		return
+	}
+
+	ttl := minTTL(msg)
+	if ttl == 0 {
+		return
+	}
+
+	e := &cacheEntry{msg: msg.Copy(), stored: c.now()}
+	e.expire = e.stored.Add(time.Duration(ttl) * time.Second)
+	if scope > 0 && subnet != nil {
+		_, bits := subnet.Mask.Size()
+		if int(scope) > bits {
+			scope = uint8(bits)
+		}
+		mask := net.CIDRMask(int(scope), bits)
+		e.network = &net.IPNet{IP: subnet.IP.Mask(mask), Mask: mask}
+		e.scope = scope
+	}
+
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if c.count >= c.size {
+		c.expire()
+	}
+	// still full, some question has to go
+	for k, v := range c.entries {
+		if c.count < c.size {
+			break
+		}
+		c.count -= len(v)
+		delete(c.entries, k)
+	}
+
+	k := c.key(r)
+	entries := c.entries[k][:0]
+	for _, v := range c.entries[k] {
+		if v.scope == e.scope && (v.network == nil || v.network.IP.Equal(e.network.IP)) {
+			c.count--
+			continue
+		}
+		entries = append(entries, v)
+	}
+	c.entries[k] = append(entries, e)
+	c.count++
+}
+
+// Clean remove expired answers every interval until context is done
+func (c *Cache) Clean(ctx context.Context, interval time.Duration) {
+
+	if c.size <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.mux.Lock()
+			c.expire()
+			c.mux.Unlock()
+		}
+	}
+}
+
+// expire remove expired answers, caller holds lock
+func (c *Cache) expire() {
+	now := c.now()
+	for k, v := range c.entries {
+		entries := v[:0]
+		for _, e := range v {
+			if now.Before(e.expire) {
+				entries = append(entries, e)
+			} else {
+				c.count--
+			}
+		}
+		if len(entries) == 0 {
+			delete(c.entries, k)
+		} else {
+			c.entries[k] = entries
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package dns
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+func TestCache(t *testing.T) {
+
+	c := NewCache(10)
+	now := time.Unix(1700000000, 0)
+	c.now = func() time.Time { return now }
+
+	r := &dns.Msg{}
+	r.SetQuestion("example.com.", dns.TypeA)
+
+	reply := func(ip string) *dns.Msg {
+		msg := &dns.Msg{}
+		msg.SetReply(r)
+		msg.Answer = append(msg.Answer, &dns.A{
+			Hdr: dns.RR_Header{Name: "example.com.", Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60},
+			A:   net.ParseIP(ip),
+		})
+		return msg
+	}
+	subnet := func(s string) *net.IPNet {
+		_, n, _ := net.ParseCIDR(s)
+		return n
+	}
+	get := func(s string) string {
+		msg, _, ok := c.Get(r, subnet(s))
+		if !ok {
+			return ""
+		}
+		return msg.Answer[0].(*dns.A).A.String()
+	}
+
+	c.Set(r, reply("192.0.2.1"), subnet("198.51.100.0/24"), 0)
+	c.Set(r, reply("192.0.2.2"), subnet("203.0.113.0/24"), 16)
+
+	if got := get("203.0.200.0/24"); got != "192.0.2.2" {
+		t.Errorf("answer of scope = %v", got)
+	}
+	if got := get("198.51.100.0/24"); got != "192.0.2.1" {
+		t.Errorf("global answer = %v", got)
+	}
+
+	// ttl decreases with age, expired answers are gone
+	now = now.Add(20 * time.Second)
+	msg, scope, ok := c.Get(r, subnet("203.0.1.0/24"))
+	if !ok || scope != 16 || msg.Answer[0].Header().Ttl != 40 {
+		t.Errorf("Get() = %v, scope %v", msg, scope)
+	}
+	now = now.Add(time.Minute)
+	if got := get("203.0.1.0/24"); got != "" {
+		t.Errorf("expired answer = %v", got)
+	}
+
+	// failures are not cached
+	fail := reply("192.0.2.3")
+	fail.Rcode = dns.RcodeServerFailure
+	c.Set(r, fail, nil, 0)
+	if got := get("198.51.100.0/24"); got != "" {
+		t.Errorf("cached failure = %v", got)
+	}
+
+	// size is never exceeded
+	for i := 0; i < 20; i++ {
+		q := &dns.Msg{}
+		q.SetQuestion(dns.Fqdn(string(rune('a'+i))+".example.com"), dns.TypeA)
+		c.Set(q, reply("192.0.2.4"), nil, 0)
+	}
+	if c.count > c.size {
+		t.Errorf("count = %v, size %v", c.count, c.size)
+	}
+}
@@ -0,0 +1,172 @@
+package dns
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+	"golang.org/x/crypto/acme"
+	"golang.org/x/crypto/acme/autocert"
+)
+
+// certCheck how often files of certificate are checked for changes
+const certCheck = time.Second
+
+var errNoCertificate = errors.New("tls: no certificate configured")
+
+// Certificate tls certificate from files, reloaded when files change,
+// with Manager certificates of server names are obtained from ACME CA
+type Certificate struct {
+	CertFile string
+	KeyFile  string
+	Manager  *autocert.Manager
+	cert     *tls.Certificate
+	mtime    time.Time
+	checked  time.Time
+	mux      sync.Mutex
+}
+
+// NewCertificate simple constructor
+func NewCertificate(certFile, keyFile string) *Certificate {
+	return &Certificate{
+		CertFile: certFile,
+		KeyFile:  keyFile,
+	}
+}
+
+// NewManager manager of certificates from ACME CA, Let's Encrypt without directory,
+// certificates are stored in cache dir and only obtained for names allowed by hosts
+func NewManager(directory, email, cacheDir string, hosts func(name string) error) *autocert.Manager {
+	m := &autocert.Manager{
+		Prompt: autocert.AcceptTOS,
+		Cache:  autocert.DirCache(cacheDir),
+		Email:  email,
+		HostPolicy: func(_ context.Context, host string) error {
+			return hosts(host)
+		},
+	}
+	if directory != "" {
+		m.Client = &acme.Client{DirectoryURL: directory}
+	}
+	return m
+}
+
+// TLSConfig config for listeners with application protocols,
+// with manager handshakes of tls-alpn-01 challenges are answered too
+func (c *Certificate) TLSConfig(protos ...string) *tls.Config {
+	if c.Manager != nil {
+		protos = append(protos, acme.ALPNProto)
+	}
+	return &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		NextProtos:     protos,
+		GetCertificate: c.GetCertificate,
+	}
+}
+
+// GetCertificate certificate of server name from ACME CA, certificate of files for other names,
+// files are reloaded when they are changed
+func (c *Certificate) GetCertificate(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+
+	if c.Manager != nil && hello != nil && hello.ServerName != "" {
+		cert, err := c.Manager.GetCertificate(hello)
+		if err == nil || c.CertFile == "" {
+			return cert, err
+		}
+	}
+
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if c.cert != nil && time.Since(c.checked) < certCheck {
+		return c.cert, nil
+	}
+	c.checked = time.Now()
+
+	if err := c.load(); err != nil {
+		// keep serving old certificate while new files are broken
+		if c.cert != nil {
+			return c.cert, nil
+		}
+		return nil, err
+	}
+
+	return c.cert, nil
+}
+
+// Load read files of certificate if they were changed, without files certificates come from ACME CA
+func (c *Certificate) Load() error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	if c.Manager != nil && c.CertFile == "" && c.KeyFile == "" {
+		return nil
+	}
+	return c.load()
+}
+
+// load read files of certificate if they were changed, caller holds lock
+func (c *Certificate) load() error {
+
+	if c.CertFile == "" || c.KeyFile == "" {
+		return errNoCertificate
+	}
+
+	var mtime time.Time
+	for _, v := range []string{c.CertFile, c.KeyFile} {
+		fi, err := os.Stat(v)
+		if err != nil {
+			return err
+		}
+		if fi.ModTime().After(mtime) {
+			mtime = fi.ModTime()
+		}
+	}
+
+	if c.cert != nil && mtime.Equal(c.mtime) {
+		return nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+	if err != nil {
+		return err
+	}
+
+	c.cert, c.mtime = &cert, mtime
+	return nil
+}
+
+// served names certificates are obtained for: configured host names, name servers of served zones
+// and policy hosts mta-sts.<zone> of zones with MTA-STS policy; other names are refused,
+// so clients can not make the server request certificates of arbitrary names
+func (s *DNS) served(name string) error {
+	name = strings.ToLower(dns.Fqdn(name))
+	for _, v := range s.Config.TlsAcmeHosts {
+		if strings.ToLower(dns.Fqdn(v)) == name {
+			return nil
+		}
+	}
+	for _, zones := range s.zoneSets() {
+		entry := closestZone(zones, name)
+		if entry.Domain == "" || isReverse(entry.Domain) {
+			continue
+		}
+		if d, _ := delegated(entry, name); d != nil {
+			continue
+		}
+		if entry.Mail != nil && entry.Mail.MtaSts != nil && name == "mta-sts."+strings.ToLower(entry.Domain) {
+			return nil
+		}
+		for _, v := range nameServers(entry) {
+			if owner(entry.Domain, v.Name) == name {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("tls: no certificate is obtained for %v", name)
+}
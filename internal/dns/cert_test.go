@@ -0,0 +1,188 @@
+package dns
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MarlikAlmighty/mdns/internal/config"
+	"github.com/MarlikAlmighty/mdns/internal/data"
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+// writeCert write self signed certificate for common name into files
+func writeCert(t *testing.T, certFile, keyFile, cn string, mtime time.Time) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: cn},
+		DNSNames:     []string{cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+	kb, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshal key: %v", err)
+	}
+	if err = os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatalf("write cert: %v", err)
+	}
+	if err = os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: kb}), 0o600); err != nil {
+		t.Fatalf("write key: %v", err)
+	}
+	for _, v := range []string{certFile, keyFile} {
+		if err = os.Chtimes(v, mtime, mtime); err != nil {
+			t.Fatalf("chtimes: %v", err)
+		}
+	}
+}
+
+func TestCertificate_GetCertificate(t *testing.T) {
+
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+
+	if _, err := NewCertificate("", "").GetCertificate(nil); err == nil {
+		t.Errorf("GetCertificate() without files, want error")
+	}
+
+	now := time.Now()
+	writeCert(t, certFile, keyFile, "old.example.com", now)
+
+	c := NewCertificate(certFile, keyFile)
+	if err := c.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	leaf := func() string {
+		cert, err := c.GetCertificate(nil)
+		if err != nil {
+			t.Fatalf("GetCertificate() error = %v", err)
+		}
+		x, err := x509.ParseCertificate(cert.Certificate[0])
+		if err != nil {
+			t.Fatalf("parse certificate: %v", err)
+		}
+		return x.Subject.CommonName
+	}
+
+	if got := leaf(); got != "old.example.com" {
+		t.Errorf("GetCertificate() = %v, want old.example.com", got)
+	}
+
+	// renewed files are picked up on next check
+	writeCert(t, certFile, keyFile, "new.example.com", now.Add(time.Minute))
+	c.checked = time.Time{}
+	if got := leaf(); got != "new.example.com" {
+		t.Errorf("GetCertificate() after renew = %v, want new.example.com", got)
+	}
+
+	// broken files keep old certificate
+	if err := os.WriteFile(certFile, []byte("broken"), 0o600); err != nil {
+		t.Fatalf("write cert: %v", err)
+	}
+	later := now.Add(2 * time.Minute)
+	if err := os.Chtimes(certFile, later, later); err != nil {
+		t.Fatalf("chtimes: %v", err)
+	}
+	c.checked = time.Time{}
+	if got := leaf(); got != "new.example.com" {
+		t.Errorf("GetCertificate() with broken files = %v, want new.example.com", got)
+	}
+}
+
+func TestCertificate_Manager(t *testing.T) {
+
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	cacheDir := filepath.Join(dir, "acme")
+	if err := os.Mkdir(cacheDir, 0o700); err != nil {
+		t.Fatal(err)
+	}
+
+	// certificate obtained before is in cache as key followed by chain
+	writeCert(t, certFile, keyFile, "mta-sts.example.com", time.Now())
+	var cached []byte
+	for _, v := range []string{keyFile, certFile} {
+		b, err := os.ReadFile(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cached = append(cached, b...)
+	}
+	if err := os.WriteFile(filepath.Join(cacheDir, "mta-sts.example.com"), cached, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	writeCert(t, certFile, keyFile, "dot.example.net", time.Now())
+
+	d := data.New()
+	d.Set("example.com.", &models.DNSEntry{Domain: "example.com.", Mail: &models.MailPolicy{MtaSts: &models.MtaStsPolicy{}}})
+	d.Set("example.org.", &models.DNSEntry{Domain: "example.org.", NameServers: []*models.NameServer{{Name: "dns1"}}})
+	s := New(d, &config.Configuration{TlsAcme: true, TlsAcmeCacheDir: cacheDir, TlsCertFile: certFile, TlsKeyFile: keyFile,
+		TlsAcmeHosts: []string{"DoT.example.net"}})
+
+	// only configured hosts, name servers and policy hosts of MTA-STS
+	for name, want := range map[string]bool{
+		"mta-sts.example.com": true,
+		"ns1.example.com":     true,
+		"dns1.example.org":    true,
+		"dot.example.net.":    true,
+		"www.example.com":     false,
+		"example.com":         false,
+		"ns1.example.org":     false,
+		"mta-sts.example.org": false,
+		"example.net":         false,
+	} {
+		if err := s.served(name); (err == nil) != want {
+			t.Errorf("served(%v) error = %v, want allowed %v", name, err, want)
+		}
+	}
+	if !strings.Contains(strings.Join(s.Cert.TLSConfig("dot").NextProtos, " "), "acme-tls/1") {
+		t.Error("tls-alpn-01 challenges are not answered")
+	}
+
+	leaf := func(name string) string {
+		cert, err := s.Cert.GetCertificate(&tls.ClientHelloInfo{
+			ServerName:       name,
+			CipherSuites:     []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256},
+			SignatureSchemes: []tls.SignatureScheme{tls.ECDSAWithP256AndSHA256},
+			SupportedCurves:  []tls.CurveID{tls.CurveP256},
+		})
+		if err != nil {
+			t.Fatalf("GetCertificate(%v) error = %v", name, err)
+		}
+		x, err := x509.ParseCertificate(cert.Certificate[0])
+		if err != nil {
+			t.Fatalf("parse certificate: %v", err)
+		}
+		return x.Subject.CommonName
+	}
+
+	// certificate per server name, files for names outside of served zones and without server name
+	if got := leaf("mta-sts.example.com"); got != "mta-sts.example.com" {
+		t.Errorf("GetCertificate() = %v, want mta-sts.example.com", got)
+	}
+	if got := leaf("dot.example.net"); got != "dot.example.net" {
+		t.Errorf("GetCertificate() = %v, want dot.example.net", got)
+	}
+	if got := leaf(""); got != "dot.example.net" {
+		t.Errorf("GetCertificate() = %v, want dot.example.net", got)
+	}
+}
@@ -0,0 +1,244 @@
+package dns
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"log"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// sizes and lifetime of cookies (RFC 7873, layout of server cookie of RFC 9018)
+const (
+	cookieClientLen = 8
+	cookieServerLen = 16
+	cookieMaxLen    = 40
+	cookieLifetime  = time.Hour
+	cookieRenew     = 30 * time.Minute
+	cookieSkew      = 5 * time.Minute
+	cookieVersion   = 1
+)
+
+var errCookieSecret = errors.New("cookie: secret must be at least 16 bytes in hex")
+
+// Cookies server cookies of clients, signed with rotating secret
+type Cookies struct {
+	Require bool
+	static  bool
+	secrets [][]byte
+	now     func() time.Time
+	mux     sync.RWMutex
+}
+
+// NewCookies simple constructor, random secret is used when secret is empty
+func NewCookies(secret string, require bool) (*Cookies, error) {
+
+	c := &Cookies{
+		Require: require,
+		now:     time.Now,
+	}
+
+	if secret == "" {
+		c.rotate()
+		return c, nil
+	}
+
+	// servers of one anycast address share secret, which is not rotated
+	b, err := hex.DecodeString(secret)
+	if err != nil || len(b) < 16 {
+		return nil, errCookieSecret
+	}
+	c.secrets, c.static = [][]byte{b}, true
+	return c, nil
+}
+
+// Rotate replace secret every interval until context is done, cookies of previous secret stay valid
+func (c *Cookies) Rotate(ctx context.Context, interval time.Duration) {
+
+	if c.static || interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.rotate()
+		}
+	}
+}
+
+// rotate new random secret, previous one is kept for validation
+func (c *Cookies) rotate() {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		log.Fatalf("cookie secret: %v\n", err)
+	}
+	c.mux.Lock()
+	if len(c.secrets) > 0 {
+		c.secrets = [][]byte{b, c.secrets[0]}
+	} else {
+		c.secrets = [][]byte{b}
+	}
+	c.mux.Unlock()
+}
+
+// Check rcode for cookies of query: FORMERR for malformed cookie, BADCOOKIE over udp for
+// missing, stale or invalid server cookie when cookies are required, otherwise success
+func (c *Cookies) Check(r *dns.Msg, ip net.IP, udp bool) int {
+
+	client, server, present, ok := parseCookie(r)
+	if !present {
+		return dns.RcodeSuccess
+	}
+	if !ok {
+		return dns.RcodeFormatError
+	}
+
+	// over tcp the address of client is already verified
+	if !udp {
+		return dns.RcodeSuccess
+	}
+
+	// without required cookies the query is answered with fresh server cookie (RFC 7873 section 5.2.3)
+	if _, valid := c.valid(client, server, ip); !valid && c.Require {
+		return dns.RcodeBadCookie
+	}
+
+	return dns.RcodeSuccess
+}
+
+// Valid query has server cookie which was issued to client
+func (c *Cookies) Valid(r *dns.Msg, ip net.IP) bool {
+	client, server, _, ok := parseCookie(r)
+	if !ok || len(server) == 0 {
+		return false
+	}
+	_, valid := c.valid(client, server, ip)
+	return valid
+}
+
+// Add put cookie of client and server into OPT record of reply,
+// valid server cookie is reused until it is half an hour old
+func (c *Cookies) Add(r, msg *dns.Msg, ip net.IP) {
+
+	if c == nil {
+		return
+	}
+
+	opt := msg.IsEdns0()
+	client, server, _, ok := parseCookie(r)
+	if opt == nil || !ok {
+		return
+	}
+
+	cookie := server
+	if ts, valid := c.valid(client, server, ip); !valid || c.now().Sub(ts) > cookieRenew {
+		c.mux.RLock()
+		secret := c.secrets[0]
+		c.mux.RUnlock()
+		cookie = c.server(client, ip, uint32(c.now().Unix()), secret)
+	}
+
+	opt.Option = append(opt.Option, &dns.EDNS0_COOKIE{
+		Code:   dns.EDNS0COOKIE,
+		Cookie: hex.EncodeToString(client) + hex.EncodeToString(cookie),
+	})
+}
+
+// valid server cookie was made with one of secrets for client and is not expired
+func (c *Cookies) valid(client, server []byte, ip net.IP) (time.Time, bool) {
+
+	if len(server) != cookieServerLen || server[0] != cookieVersion {
+		return time.Time{}, false
+	}
+
+	ts := time.Unix(int64(binary.BigEndian.Uint32(server[4:8])), 0)
+	if age := c.now().Sub(ts); age > cookieLifetime || age < -cookieSkew {
+		return ts, false
+	}
+
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+	for _, secret := range c.secrets {
+		if hmac.Equal(server, c.server(client, ip, uint32(ts.Unix()), secret)) {
+			return ts, true
+		}
+	}
+	return ts, false
+}
+
+// server cookie: version, reserved, timestamp and hmac of client cookie, these fields and client address
+func (c *Cookies) server(client []byte, ip net.IP, ts uint32, secret []byte) []byte {
+
+	b := make([]byte, 8, cookieServerLen)
+	b[0] = cookieVersion
+	binary.BigEndian.PutUint32(b[4:], ts)
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(client)
+	mac.Write(b)
+	if ip4 := ip.To4(); ip4 != nil {
+		mac.Write(ip4)
+	} else {
+		mac.Write(ip.To16())
+	}
+
+	return append(b, mac.Sum(nil)[:8]...)
+}
+
+// parseCookie cookies of client and server from OPT record of query,
+// present is false without cookie, ok is false for malformed cookie
+func parseCookie(r *dns.Msg) (client, server []byte, present, ok bool) {
+
+	opt := r.IsEdns0()
+	if opt == nil {
+		return nil, nil, false, false
+	}
+
+	for _, o := range opt.Option {
+		v, isCookie := o.(*dns.EDNS0_COOKIE)
+		if !isCookie {
+			continue
+		}
+		b, err := hex.DecodeString(v.Cookie)
+		if err != nil || len(b) < cookieClientLen || len(b) > cookieMaxLen ||
+			(len(b) > cookieClientLen && len(b) < cookieClientLen+8) {
+			return nil, nil, true, false
+		}
+		return b[:cookieClientLen], b[cookieClientLen:], true, true
+	}
+
+	return nil, nil, false, false
+}
+
+// withoutCookie copy of query without cookies, which are meant for this server only
+func withoutCookie(r *dns.Msg) *dns.Msg {
+
+	if _, _, present, _ := parseCookie(r); !present {
+		return r
+	}
+
+	m := r.Copy()
+	opt := m.IsEdns0()
+	options := opt.Option[:0]
+	for _, o := range opt.Option {
+		if o.Option() != dns.EDNS0COOKIE {
+			options = append(options, o)
+		}
+	}
+	opt.Option = options
+	return m
+}
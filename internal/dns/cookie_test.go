@@ -0,0 +1,143 @@
+package dns
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+func TestCookies(t *testing.T) {
+
+	c, err := NewCookies("", false)
+	if err != nil {
+		t.Fatalf("NewCookies() error = %v", err)
+	}
+	now := time.Unix(1700000000, 0)
+	c.now = func() time.Time { return now }
+
+	client := net.ParseIP("192.0.2.1")
+
+	query := func(cookie string) *dns.Msg {
+		r := &dns.Msg{}
+		r.SetQuestion("example.com.", dns.TypeA)
+		r.SetEdns0(1232, false)
+		if cookie != "" {
+			opt := r.IsEdns0()
+			opt.Option = append(opt.Option, &dns.EDNS0_COOKIE{Code: dns.EDNS0COOKIE, Cookie: cookie})
+		}
+		return r
+	}
+
+	// server cookie of reply to query with client cookie only
+	issue := func(clientCookie string) string {
+		r := query(clientCookie)
+		msg := &dns.Msg{}
+		msg.SetReply(r)
+		msg.SetEdns0(1232, false)
+		c.Add(r, msg, client)
+		for _, o := range msg.IsEdns0().Option {
+			if v, ok := o.(*dns.EDNS0_COOKIE); ok {
+				return v.Cookie
+			}
+		}
+		t.Fatalf("reply without cookie")
+		return ""
+	}
+
+	cookie := issue("0102030405060708")
+	if len(cookie) != 2*(cookieClientLen+cookieServerLen) || cookie[:16] != "0102030405060708" {
+		t.Fatalf("cookie = %v", cookie)
+	}
+
+	tests := []struct {
+		name   string
+		cookie string
+		ip     string
+		udp    bool
+		want   int
+		valid  bool
+	}{
+		{"no_cookie", "", "192.0.2.1", true, dns.RcodeSuccess, false},
+		{"client_cookie", "0102030405060708", "192.0.2.1", true, dns.RcodeSuccess, false},
+		{"valid_server_cookie", cookie, "192.0.2.1", true, dns.RcodeSuccess, true},
+		{"other_client_address", cookie, "192.0.2.2", true, dns.RcodeSuccess, false},
+		{"other_client_cookie", "1102030405060708" + cookie[16:], "192.0.2.1", true, dns.RcodeSuccess, false},
+		{"invalid_over_tcp", "1102030405060708" + cookie[16:], "192.0.2.1", false, dns.RcodeSuccess, false},
+		{"malformed", "010203", "192.0.2.1", true, dns.RcodeFormatError, false},
+		{"short_server_cookie", "0102030405060708aabb", "192.0.2.1", true, dns.RcodeFormatError, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := query(tt.cookie)
+			ip := net.ParseIP(tt.ip)
+			if got := c.Check(r, ip, tt.udp); got != tt.want {
+				t.Errorf("Check() = %v, want %v", dns.RcodeToString[got], dns.RcodeToString[tt.want])
+			}
+			if got := c.Valid(r, ip); got != tt.valid {
+				t.Errorf("Valid() = %v, want %v", got, tt.valid)
+			}
+		})
+	}
+
+	// young cookie is reused, old one is renewed
+	if got := issue(cookie); got != cookie {
+		t.Errorf("young cookie renewed: %v", got)
+	}
+	now = now.Add(cookieRenew + time.Minute)
+	if got := issue(cookie); got == cookie {
+		t.Errorf("old cookie not renewed")
+	}
+
+	// cookie of previous secret is valid after one rotation only
+	c.rotate()
+	if !c.Valid(query(cookie), client) {
+		t.Errorf("cookie of previous secret invalid")
+	}
+	c.rotate()
+	if c.Valid(query(cookie), client) {
+		t.Errorf("cookie of secret before previous valid")
+	}
+
+	// expired cookie is answered with fresh one
+	fresh := issue("0102030405060708")
+	now = now.Add(cookieLifetime + time.Minute)
+	if c.Valid(query(fresh), client) {
+		t.Errorf("expired cookie valid")
+	}
+	if got := issue(fresh); got == fresh || !c.Valid(query(got), client) {
+		t.Errorf("expired cookie not replaced: %v", got)
+	}
+
+	// required cookies
+	c.Require = true
+	if got := c.Check(query("0102030405060708"), client, true); got != dns.RcodeBadCookie {
+		t.Errorf("Check() required = %v, want BADCOOKIE", dns.RcodeToString[got])
+	}
+	if got := c.Check(query(fresh), client, true); got != dns.RcodeBadCookie {
+		t.Errorf("Check() required with expired cookie = %v, want BADCOOKIE", dns.RcodeToString[got])
+	}
+
+	if _, err = NewCookies("abcd", false); err == nil {
+		t.Errorf("NewCookies() with short secret got no error")
+	}
+}
+
+func TestWithoutCookie(t *testing.T) {
+	r := &dns.Msg{}
+	r.SetQuestion("example.com.", dns.TypeA)
+	r.SetEdns0(1232, false)
+	opt := r.IsEdns0()
+	opt.Option = append(opt.Option,
+		&dns.EDNS0_COOKIE{Code: dns.EDNS0COOKIE, Cookie: "0102030405060708"},
+		&dns.EDNS0_NSID{Code: dns.EDNS0NSID})
+
+	m := withoutCookie(r)
+	if len(m.IsEdns0().Option) != 1 || m.IsEdns0().Option[0].Option() != dns.EDNS0NSID {
+		t.Errorf("options = %v", m.IsEdns0().Option)
+	}
+	if len(r.IsEdns0().Option) != 2 {
+		t.Errorf("query changed: %v", r.IsEdns0().Option)
+	}
+}
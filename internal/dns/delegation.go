@@ -0,0 +1,118 @@
+package dns
+
+import (
+	"net"
+	"strings"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	"github.com/miekg/dns"
+)
+
+// nameServers NS set of zone, ns1 and ns2 of zone without configured name servers
+func nameServers(entry *models.DNSEntry) []*models.NameServer {
+	var servers []*models.NameServer
+	for _, v := range entry.NameServers {
+		if v != nil && v.Name != "" {
+			servers = append(servers, v)
+		}
+	}
+	if len(servers) == 0 {
+		servers = []*models.NameServer{{Name: "ns1"}, {Name: "ns2"}}
+	}
+	return servers
+}
+
+// delegated closest child zone containing name with its owner name, nil when zone answers name itself
+func delegated(entry *models.DNSEntry, name string) (*models.Delegation, string) {
+
+	var (
+		closest *models.Delegation
+		child   string
+	)
+
+	name = strings.ToLower(name)
+	for _, v := range entry.Delegations {
+		if v == nil || v.Name == "" {
+			continue
+		}
+		cut := owner(entry.Domain, v.Name)
+		if dns.IsSubDomain(cut, name) && dns.CountLabel(cut) > dns.CountLabel(child) {
+			closest, child = v, cut
+		}
+	}
+
+	return closest, child
+}
+
+// hostAddresses addresses of name server of zone or of its child zones with name, nil without addresses
+func hostAddresses(entry *models.DNSEntry, name string) *models.NameServer {
+
+	name = strings.ToLower(name)
+	servers := nameServers(entry)
+	for _, v := range entry.Delegations {
+		if v != nil {
+			servers = append(servers, v.NameServers...)
+		}
+	}
+
+	for _, v := range servers {
+		if v != nil && len(v.Ipv4s)+len(v.Ipv6s) > 0 && owner(entry.Domain, v.Name) == name {
+			return v
+		}
+	}
+	return nil
+}
+
+// glue A and AAAA records of name servers below zone, addresses of other name servers are not ours to give
+func glue(zone string, servers []*models.NameServer) []dns.RR {
+
+	var rrs []dns.RR
+	for _, v := range servers {
+		if v == nil {
+			continue
+		}
+		name := owner(zone, v.Name)
+		if !dns.IsSubDomain(strings.ToLower(zone), name) {
+			continue
+		}
+		header := dns.RR_Header{Name: name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 600}
+		for _, ip := range v.Ipv4s {
+			rrs = append(rrs, &dns.A{Hdr: header, A: net.ParseIP(ip)})
+		}
+		header.Rrtype = dns.TypeAAAA
+		for _, ip := range v.Ipv6s {
+			rrs = append(rrs, &dns.AAAA{Hdr: header, AAAA: net.ParseIP(ip)})
+		}
+	}
+	return rrs
+}
+
+// referral answer query below cut with NS records of child zone and glue, without authority for child zone
+func (s *DNS) referral(msg *dns.Msg, entry *models.DNSEntry, d *models.Delegation, child string) {
+
+	msg.Authoritative = false
+	for _, v := range d.NameServers {
+		if v == nil || v.Name == "" {
+			continue
+		}
+		msg.Ns = append(msg.Ns, &dns.NS{
+			Hdr: dns.RR_Header{Name: child, Rrtype: dns.TypeNS, Class: dns.ClassINET, Ttl: 600},
+			Ns:  owner(entry.Domain, v.Name),
+		})
+	}
+	msg.Extra = append(msg.Extra, glue(entry.Domain, d.NameServers)...)
+}
+
+// host answer A and AAAA queries of name server with its addresses
+func (s *DNS) host(msg *dns.Msg, ns *models.NameServer, header dns.RR_Header) {
+	switch header.Rrtype {
+	case dns.TypeA:
+		for _, ip := range ns.Ipv4s {
+			msg.Answer = append(msg.Answer, &dns.A{Hdr: header, A: net.ParseIP(ip)})
+		}
+	case dns.TypeAAAA:
+		for _, ip := range ns.Ipv6s {
+			msg.Answer = append(msg.Answer, &dns.AAAA{Hdr: header, AAAA: net.ParseIP(ip)})
+		}
+	}
+}
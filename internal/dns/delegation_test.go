@@ -0,0 +1,79 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	"github.com/miekg/dns"
+)
+
+func TestDelegation(t *testing.T) {
+
+	s := &DNS{}
+	entry := &models.DNSEntry{
+		Domain: "example.com.",
+		Ipv4s:  []string{"192.0.2.1"},
+		NameServers: []*models.NameServer{
+			{Name: "ns1", Ipv4s: []string{"192.0.2.53"}, Ipv6s: []string{"2001:db8::53"}},
+			{Name: "ns.example.net."},
+		},
+		Delegations: []*models.Delegation{
+			{Name: "sub", NameServers: []*models.NameServer{
+				{Name: "ns.sub", Ipv4s: []string{"198.51.100.53"}},
+				{Name: "ns.example.org."},
+			}},
+		},
+	}
+
+	query := func(name string, qtype uint16) *dns.Msg {
+		msg := &dns.Msg{}
+		msg.SetQuestion(name, qtype)
+		s.answer(msg, entry, nil, nil)
+		return msg
+	}
+
+	// NS set of zone with glue of name servers below zone
+	msg := query("example.com.", dns.TypeNS)
+	if !msg.Authoritative || len(msg.Answer) != 2 || len(msg.Extra) != 2 {
+		t.Fatalf("unexpected NS answer %v", msg)
+	}
+	if ns := msg.Answer[0].(*dns.NS).Ns; ns != "ns1.example.com." {
+		t.Errorf("got name server %v", ns)
+	}
+
+	// name server answers with its own addresses
+	msg = query("ns1.example.com.", dns.TypeA)
+	if len(msg.Answer) != 1 || msg.Answer[0].(*dns.A).A.String() != "192.0.2.53" {
+		t.Errorf("unexpected address of name server %v", msg.Answer)
+	}
+
+	// names at and below cut are referred to child zone
+	for _, name := range []string{"sub.example.com.", "www.sub.example.com."} {
+		msg = query(name, dns.TypeA)
+		if msg.Authoritative || len(msg.Answer) != 0 || len(msg.Ns) != 2 || len(msg.Extra) != 1 {
+			t.Fatalf("unexpected referral for %v: %v", name, msg)
+		}
+		if v := msg.Ns[0].(*dns.NS); v.Hdr.Name != "sub.example.com." || v.Ns != "ns.sub.example.com." {
+			t.Errorf("unexpected NS of referral %v", v)
+		}
+	}
+
+	// DS of cut belongs to parent zone
+	msg = query("sub.example.com.", dns.TypeDS)
+	if !msg.Authoritative || len(msg.Answer) != 0 || len(msg.Ns) != 1 || msg.Ns[0].Header().Rrtype != dns.TypeSOA {
+		t.Errorf("unexpected DS answer %v", msg)
+	}
+
+	// other names stay in zone
+	msg = query("www.example.com.", dns.TypeA)
+	if !msg.Authoritative || len(msg.Answer) != 1 {
+		t.Errorf("unexpected answer %v", msg)
+	}
+}
+
+func TestNameServersDefault(t *testing.T) {
+	servers := nameServers(&models.DNSEntry{Domain: "example.com."})
+	if len(servers) != 2 || owner("example.com.", servers[1].Name) != "ns2.example.com." {
+		t.Errorf("unexpected default name servers %v", servers)
+	}
+}
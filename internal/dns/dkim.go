@@ -0,0 +1,490 @@
+package dns
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MarlikAlmighty/mdns/internal/data"
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	"github.com/miekg/dns"
+)
+
+// algorithms of DKIM keys
+const (
+	dkimRSA2048 = "rsa-2048"
+	dkimRSA4096 = "rsa-4096"
+	dkimEd25519 = "ed25519"
+)
+
+// states of DKIM keys
+const (
+	dkimPublished = "published"
+	dkimActive    = "active"
+	dkimRetired   = "retired"
+	dkimRevoked   = "revoked"
+)
+
+// dkimRetire default time retired and revoked keys stay published
+const dkimRetire = 7 * 24 * time.Hour
+
+// DKIM keys of zones: new selectors are published before they sign, retired selectors stay published
+// for a while, so signatures in flight can be verified
+type DKIM struct {
+	publish time.Duration
+	retire  time.Duration
+	rotate  time.Duration
+	now     func() time.Time
+	mux     sync.Mutex
+}
+
+// NewDKIM simple constructor, zero rotate disables scheduled rotation
+func NewDKIM(publish, retire, rotate time.Duration) *DKIM {
+	return &DKIM{
+		publish: publish,
+		retire:  retire,
+		rotate:  rotate,
+		now:     time.Now,
+	}
+}
+
+// NewDKIMKey generate key for selector, rsa-2048 by default; private keys of rsa are PKCS1, of ed25519 PKCS8
+func NewDKIMKey(selector, algorithm string) (*models.DkimKey, error) {
+
+	if algorithm == "" {
+		algorithm = dkimRSA2048
+	}
+	md := &models.DkimKey{Selector: strings.ToLower(selector), Algorithm: algorithm}
+
+	var (
+		pub, priv []byte
+		err       error
+	)
+	switch algorithm {
+	case dkimRSA2048, dkimRSA4096:
+		bits := 2048
+		if algorithm == dkimRSA4096 {
+			bits = 4096
+		}
+		var key *rsa.PrivateKey
+		if key, err = rsa.GenerateKey(rand.Reader, bits); err != nil {
+			return nil, err
+		}
+		if pub, err = x509.MarshalPKIXPublicKey(&key.PublicKey); err != nil {
+			return nil, err
+		}
+		priv = x509.MarshalPKCS1PrivateKey(key)
+	case dkimEd25519:
+		var key ed25519.PrivateKey
+		if pub, key, err = ed25519.GenerateKey(rand.Reader); err != nil {
+			return nil, err
+		}
+		if priv, err = x509.MarshalPKCS8PrivateKey(key); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("dkim: invalid algorithm %q", algorithm)
+	}
+
+	md.PublicKey = base64.StdEncoding.EncodeToString(pub)
+	md.PrivateKey = base64.StdEncoding.EncodeToString(priv)
+	return md, nil
+}
+
+// dkimRecord value of TXT record of key (RFC 6376, RFC 8463), revoked keys have empty public key
+func dkimRecord(key *models.DkimKey) string {
+	k, p := "rsa", key.PublicKey
+	if key.Algorithm == dkimEd25519 {
+		k = "ed25519"
+	}
+	if key.Revoked != "" {
+		p = ""
+	}
+	return fmt.Sprintf("v=DKIM1; k=%s; p=%s", k, p)
+}
+
+// dkimState state of key at time
+func dkimState(key *models.DkimKey, now time.Time) string {
+	switch {
+	case key.Revoked != "":
+		return dkimRevoked
+	case key.Retired != "" && !now.Before(timeOf(key.Retired)):
+		return dkimRetired
+	case key.Activated != "" && !now.Before(timeOf(key.Activated)):
+		return dkimActive
+	}
+	return dkimPublished
+}
+
+// ended key which is no longer published at time
+func ended(key *models.DkimKey, now time.Time, retire time.Duration) bool {
+	end := key.Retired
+	if key.Revoked != "" {
+		end = key.Revoked
+	}
+	return end != "" && !now.Before(timeOf(end).Add(retire))
+}
+
+// stamp time of key in RFC 3339 format
+func stamp(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// timeOf time of stamp, zero time for invalid stamp
+func timeOf(v string) time.Time {
+	t, _ := time.Parse(time.RFC3339, v)
+	return t
+}
+
+// published keys of zone with selector, all selectors for empty selector
+func (k *DKIM) published(entry *models.DNSEntry, selector string) []*models.DkimKey {
+
+	now, retire := time.Now(), dkimRetire
+	if k != nil {
+		now, retire = k.now(), k.retire
+	}
+
+	var keys []*models.DkimKey
+	for _, v := range entry.DkimKeys {
+		if v != nil && (selector == "" || v.Selector == selector) && !ended(v, now, retire) {
+			keys = append(keys, v)
+		}
+	}
+	return keys
+}
+
+// active signing key of zone, nil without
+func active(entry *models.DNSEntry, now time.Time) *models.DkimKey {
+	var found *models.DkimKey
+	for _, v := range entry.DkimKeys {
+		if v == nil || dkimState(v, now) != dkimActive {
+			continue
+		}
+		if found == nil || timeOf(v.Activated).After(timeOf(found.Activated)) {
+			found = v
+		}
+	}
+	return found
+}
+
+// pending key of zone which is published and waits for activation, nil without
+func pending(entry *models.DNSEntry, now time.Time) *models.DkimKey {
+	for _, v := range entry.DkimKeys {
+		if v != nil && v.Activated != "" && dkimState(v, now) == dkimPublished {
+			return v
+		}
+	}
+	return nil
+}
+
+// mirror keep keys of entry of active key in sync, true when they changed
+func mirror(entry *models.DNSEntry, now time.Time) bool {
+	key := active(entry, now)
+	if key == nil || entry.DkimPublicKey == key.PublicKey {
+		return false
+	}
+	entry.DkimPublicKey, entry.DkimPrivateKey = key.PublicKey, key.PrivateKey
+	return true
+}
+
+// withoutPrivate copy of key with state at time and without private key
+func withoutPrivate(key *models.DkimKey, now time.Time) *models.DkimKey {
+	md := *key
+	md.PrivateKey = ""
+	md.State = dkimState(key, now)
+	return &md
+}
+
+// DomainKey key of zone in zones, lowercase and fully qualified
+func DomainKey(domain string) string {
+	return strings.ToLower(dns.Fqdn(domain))
+}
+
+// copyKeys give entry own copies of its keys, answers in flight read the stored ones
+func copyKeys(entry *models.DNSEntry) {
+	keys := make([]*models.DkimKey, 0, len(entry.DkimKeys))
+	for _, v := range entry.DkimKeys {
+		if v != nil {
+			md := *v
+			keys = append(keys, &md)
+		}
+	}
+	entry.DkimKeys = keys
+}
+
+// entry snapshot of zone with own copy of keys
+func (k *DKIM) entry(r *data.ResolvedData, domain string) (*models.DNSEntry, error) {
+	entry := r.Get(DomainKey(domain))
+	if entry.Domain == "" {
+		return nil, errors.New("domain does not exist")
+	}
+	copyKeys(entry)
+	return entry, nil
+}
+
+// update change keys of zone in place, other fields of entry may change meanwhile
+func (k *DKIM) update(r *data.ResolvedData, domain string, fn func(entry *models.DNSEntry) error) error {
+	return r.Update(DomainKey(domain), func(entry *models.DNSEntry) error {
+		if entry.Domain == "" {
+			return errors.New("domain does not exist")
+		}
+		copyKeys(entry)
+		return fn(entry)
+	})
+}
+
+// addable check new selector for zone
+func addable(entry *models.DNSEntry, selector string, now time.Time) error {
+	if _, ok := dns.IsDomainName(selector + "._domainkey." + entry.Domain); !ok || selector == "" {
+		return fmt.Errorf("invalid selector %q", selector)
+	}
+	for _, v := range entry.DkimKeys {
+		if v != nil && v.Selector == selector {
+			return fmt.Errorf("selector %q exists", selector)
+		}
+	}
+	if v := pending(entry, now); v != nil {
+		return fmt.Errorf("selector %q waits for activation", v.Selector)
+	}
+	return nil
+}
+
+// add generate key for zone, it signs at once when zone has no active key, otherwise after publish delay,
+// when the active key retires; the key is generated before the zone is locked; caller holds lock
+func (k *DKIM) add(r *data.ResolvedData, domain, selector, algorithm string) (*models.DkimKey, error) {
+
+	selector = strings.ToLower(strings.TrimSpace(selector))
+	entry, err := k.entry(r, domain)
+	if err != nil {
+		return nil, err
+	}
+	if err = addable(entry, selector, k.now()); err != nil {
+		return nil, err
+	}
+
+	key, err := NewDKIMKey(selector, algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	now := k.now()
+	err = k.update(r, domain, func(entry *models.DNSEntry) error {
+		if err := addable(entry, selector, now); err != nil {
+			return err
+		}
+		key.Created = stamp(now)
+		if current := active(entry, now); current == nil {
+			key.Activated = stamp(now)
+		} else {
+			key.Activated = stamp(now.Add(k.publish))
+			current.Retired = key.Activated
+		}
+		entry.DkimKeys = append(entry.DkimKeys, key)
+		mirror(entry, now)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return withoutPrivate(key, now), nil
+}
+
+// Add add key with selector to zone
+func (k *DKIM) Add(r *data.ResolvedData, domain string, md *models.DkimKey) (*models.DkimKey, error) {
+
+	k.mux.Lock()
+	defer k.mux.Unlock()
+
+	if md.Selector == "" {
+		return nil, errors.New("selector required")
+	}
+	return k.add(r, domain, md.Selector, md.Algorithm)
+}
+
+// Rotate add key with new selector to zone, selector from time and algorithm of active key by default
+func (k *DKIM) Rotate(r *data.ResolvedData, domain string, md *models.DkimKey) (*models.DkimKey, error) {
+
+	k.mux.Lock()
+	defer k.mux.Unlock()
+
+	return k.rotateDomain(r, domain, md.Selector, md.Algorithm)
+}
+
+// rotateDomain rotate key of zone, caller holds lock
+func (k *DKIM) rotateDomain(r *data.ResolvedData, domain, selector, algorithm string) (*models.DkimKey, error) {
+	entry, err := k.entry(r, domain)
+	if err != nil {
+		return nil, err
+	}
+	now := k.now()
+	if selector == "" {
+		selector = "s" + now.UTC().Format("200601021504")
+	}
+	if current := active(entry, now); algorithm == "" && current != nil {
+		algorithm = current.Algorithm
+	}
+	return k.add(r, domain, selector, algorithm)
+}
+
+// Revoke stop signing with key, selector is published with empty key until retire period ends;
+// revoking a key which waits for activation keeps the key it should replace
+func (k *DKIM) Revoke(r *data.ResolvedData, domain, selector string) error {
+
+	k.mux.Lock()
+	defer k.mux.Unlock()
+
+	now := k.now()
+	return k.update(r, domain, func(entry *models.DNSEntry) error {
+		for _, v := range entry.DkimKeys {
+			if v.Selector != strings.ToLower(selector) {
+				continue
+			}
+			if v.Activated != "" && dkimState(v, now) == dkimPublished {
+				for _, o := range entry.DkimKeys {
+					if o != v && o.Revoked == "" && o.Retired == v.Activated {
+						o.Retired = ""
+					}
+				}
+			}
+			if v.Revoked == "" {
+				v.Revoked = stamp(now)
+			}
+			if v.Retired == "" || timeOf(v.Retired).After(now) {
+				v.Retired = stamp(now)
+			}
+			if entry.DkimPublicKey == v.PublicKey {
+				entry.DkimPublicKey, entry.DkimPrivateKey = "", ""
+			}
+			mirror(entry, now)
+			return nil
+		}
+		return fmt.Errorf("selector %q does not exist", selector)
+	})
+}
+
+// Keys keys of zone with their state, without private keys
+func (k *DKIM) Keys(r *data.ResolvedData, domain string) (models.DkimKeys, error) {
+
+	k.mux.Lock()
+	defer k.mux.Unlock()
+
+	entry, err := k.entry(r, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	now := k.now()
+	keys := models.DkimKeys{}
+	for _, v := range entry.DkimKeys {
+		if v != nil {
+			keys = append(keys, withoutPrivate(v, now))
+		}
+	}
+	return keys, nil
+}
+
+// Export private key of selector in PEM format
+func (k *DKIM) Export(r *data.ResolvedData, domain, selector string) (*models.DkimPem, error) {
+
+	k.mux.Lock()
+	defer k.mux.Unlock()
+
+	entry, err := k.entry(r, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range entry.DkimKeys {
+		if v == nil || v.Selector != strings.ToLower(selector) {
+			continue
+		}
+		der, err := base64.StdEncoding.DecodeString(v.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+		block := &pem.Block{Type: "RSA PRIVATE KEY", Bytes: der}
+		if v.Algorithm == dkimEd25519 {
+			block.Type = "PRIVATE KEY"
+		}
+		return &models.DkimPem{
+			Selector:  v.Selector,
+			Algorithm: v.Algorithm,
+			Pem:       string(pem.EncodeToMemory(block)),
+		}, nil
+	}
+
+	return nil, fmt.Errorf("selector %q does not exist", selector)
+}
+
+// Run rotate keys of zones on schedule and remove keys which are no longer published, every interval
+// until context is done; zones gives default zones and zones of views
+func (k *DKIM) Run(ctx context.Context, interval time.Duration, zones func() []*data.ResolvedData) {
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, r := range zones() {
+				k.tick(r)
+			}
+		}
+	}
+}
+
+// tick one round of rotation of all zones
+func (k *DKIM) tick(r *data.ResolvedData) {
+
+	k.mux.Lock()
+	defer k.mux.Unlock()
+
+	now := k.now()
+	for domain, v := range r.GetMap() {
+
+		if len(v.DkimKeys) == 0 {
+			continue
+		}
+
+		// keys which are no longer published go, active key changes when the published one is activated
+		rotate := false
+		err := k.update(r, domain, func(entry *models.DNSEntry) error {
+			keys := entry.DkimKeys[:0]
+			for _, v := range entry.DkimKeys {
+				if !ended(v, now, k.retire) {
+					keys = append(keys, v)
+				}
+			}
+			entry.DkimKeys = keys
+			mirror(entry, now)
+
+			current := active(entry, now)
+			rotate = k.rotate > 0 && current != nil && pending(entry, now) == nil &&
+				!now.Before(timeOf(current.Activated).Add(k.rotate))
+			return nil
+		})
+		if err != nil || !rotate {
+			continue
+		}
+
+		md, err := k.rotateDomain(r, domain, "", "")
+		if err != nil {
+			log.Printf("[ERR]: rotate dkim key of %v: %v\n", domain, err)
+			continue
+		}
+		log.Printf("[DKIM]: %v publishes selector %v\n", domain, md.Selector)
+	}
+}
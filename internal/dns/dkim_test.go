@@ -0,0 +1,204 @@
+package dns
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MarlikAlmighty/mdns/internal/config"
+	"github.com/MarlikAlmighty/mdns/internal/data"
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	"github.com/miekg/dns"
+)
+
+func TestDKIM_Rotation(t *testing.T) {
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	k := NewDKIM(time.Hour, 2*time.Hour, 24*time.Hour)
+	k.now = func() time.Time { return now }
+
+	r := data.New()
+	r.Set("example.com.", &models.DNSEntry{Domain: "example.com."})
+	s := &DNS{DKIM: k}
+
+	txt := func(name string) []string {
+		msg := &dns.Msg{}
+		msg.SetQuestion(name, dns.TypeTXT)
+		s.txt(msg, r.Get("example.com."))
+		var got []string
+		for _, rr := range msg.Answer {
+			got = append(got, strings.Join(rr.(*dns.TXT).Txt, ""))
+		}
+		return got
+	}
+
+	// first key signs at once
+	first, err := k.Add(r, "example.com", &models.DkimKey{Selector: "one", Algorithm: dkimEd25519})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.State != dkimActive || first.PrivateKey != "" {
+		t.Errorf("unexpected first key %+v", first)
+	}
+	if got := txt("one._domainkey.example.com."); len(got) != 1 || !strings.HasPrefix(got[0], "v=DKIM1; k=ed25519; p=") {
+		t.Errorf("unexpected record %v", got)
+	}
+	if r.Get("example.com.").DkimPublicKey != first.PublicKey {
+		t.Error("keys of entry do not follow active key")
+	}
+
+	// second key is published before it signs
+	second, err := k.Rotate(r, "example.com.", &models.DkimKey{Selector: "two", Algorithm: dkimRSA2048})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second.State != dkimPublished || len(txt("two._domainkey.example.com.")) != 1 {
+		t.Errorf("unexpected second key %+v", second)
+	}
+	if _, err = k.Rotate(r, "example.com.", &models.DkimKey{}); err == nil {
+		t.Error("rotation while another key waits for activation")
+	}
+
+	// after publish delay second key signs, first stays published until retire period ends
+	now = now.Add(time.Hour)
+	k.tick(r)
+	keys, _ := k.Keys(r, "example.com.")
+	if len(keys) != 2 || keys[0].State != dkimRetired || keys[1].State != dkimActive {
+		t.Fatalf("unexpected keys %+v", keys)
+	}
+	if r.Get("example.com.").DkimPublicKey != second.PublicKey {
+		t.Error("keys of entry do not follow active key")
+	}
+	if len(txt("one._domainkey.example.com.")) != 1 {
+		t.Error("retired key is not published")
+	}
+
+	now = now.Add(2 * time.Hour)
+	k.tick(r)
+	if keys, _ = k.Keys(r, "example.com."); len(keys) != 1 || len(txt("one._domainkey.example.com.")) != 0 {
+		t.Errorf("retired key is still there %+v", keys)
+	}
+
+	// scheduled rotation keeps the algorithm
+	now = now.Add(24 * time.Hour)
+	k.tick(r)
+	if keys, _ = k.Keys(r, "example.com."); len(keys) != 2 || keys[1].State != dkimPublished || keys[1].Algorithm != dkimRSA2048 {
+		t.Errorf("no scheduled rotation %+v", keys)
+	}
+
+	// revoked key is published with empty key
+	if err = k.Revoke(r, "example.com.", "two"); err != nil {
+		t.Fatal(err)
+	}
+	if got := txt("two._domainkey.example.com."); len(got) != 1 || got[0] != "v=DKIM1; k=rsa; p=" {
+		t.Errorf("unexpected revoked record %v", got)
+	}
+	if err = k.Revoke(r, "example.com.", "three"); err == nil {
+		t.Error("revoked unknown selector")
+	}
+}
+
+func TestDKIM_Export(t *testing.T) {
+
+	k := NewDKIM(time.Hour, time.Hour, 0)
+	r := data.New()
+	r.Set("example.com.", &models.DNSEntry{Domain: "example.com."})
+
+	for _, alg := range []string{dkimRSA2048, dkimEd25519} {
+		if _, err := k.Add(r, "example.com.", &models.DkimKey{Selector: alg, Algorithm: alg}); err != nil {
+			t.Fatal(err)
+		}
+		md, err := k.Export(r, "example.com.", alg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		block, _ := pem.Decode([]byte(md.Pem))
+		if block == nil {
+			t.Fatalf("no pem block for %v", alg)
+		}
+		if alg == dkimEd25519 {
+			_, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+		} else {
+			_, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+		}
+		if err != nil {
+			t.Errorf("invalid private key of %v: %v", alg, err)
+		}
+	}
+
+	if _, err := k.Add(r, "example.com.", &models.DkimKey{Selector: "bad..selector"}); err == nil {
+		t.Error("added invalid selector")
+	}
+	if _, err := k.Add(r, "example.org.", &models.DkimKey{Selector: "one"}); err == nil {
+		t.Error("added key to unknown zone")
+	}
+}
+
+func TestDKIM_RevokePending(t *testing.T) {
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	k := NewDKIM(time.Hour, 2*time.Hour, 24*time.Hour)
+	k.now = func() time.Time { return now }
+
+	r := data.New()
+	r.Set("example.com.", &models.DNSEntry{Domain: "example.com."})
+
+	if _, err := k.Add(r, "example.com.", &models.DkimKey{Selector: "one", Algorithm: dkimEd25519}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := k.Rotate(r, "example.com.", &models.DkimKey{Selector: "two", Algorithm: dkimEd25519}); err != nil {
+		t.Fatal(err)
+	}
+
+	// revoking the waiting key keeps the signing one
+	if err := k.Revoke(r, "example.com.", "two"); err != nil {
+		t.Fatal(err)
+	}
+	now = now.Add(2 * time.Hour)
+	k.tick(r)
+	keys, _ := k.Keys(r, "example.com.")
+	if len(keys) != 1 || keys[0].Selector != "one" || keys[0].State != dkimActive || keys[0].Retired != "" {
+		t.Fatalf("unexpected keys %+v", keys)
+	}
+	if active(r.Get("example.com."), now) == nil {
+		t.Fatal("zone without signing key")
+	}
+
+	// and scheduled rotation goes on
+	now = now.Add(24 * time.Hour)
+	k.tick(r)
+	if keys, _ = k.Keys(r, "example.com."); len(keys) != 2 || keys[1].State != dkimPublished || keys[1].Selector == "two" {
+		t.Errorf("no scheduled rotation %+v", keys)
+	}
+}
+
+func TestDKIM_Views(t *testing.T) {
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := New(data.New(), &config.Configuration{})
+	s.DKIM.now = func() time.Time { return now }
+	s.DKIM.rotate = time.Hour
+	if err := s.Views.Set(&models.View{Name: "internal", Clients: []string{"10.0.0.0/8"}}); err != nil {
+		t.Fatal(err)
+	}
+	zones, _ := s.zonesOf("internal")
+	zones.Set("example.com.", &models.DNSEntry{Domain: "example.com."})
+
+	if _, err := s.AddDKIMKey("internal", "example.com.", &models.DkimKey{Selector: "one", Algorithm: dkimEd25519}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.AddDKIMKey("", "example.com.", &models.DkimKey{Selector: "one"}); err == nil {
+		t.Error("added key to zone of other view")
+	}
+
+	// zones of views are rotated on schedule too
+	now = now.Add(time.Hour)
+	for _, r := range s.zoneSets() {
+		s.DKIM.tick(r)
+	}
+	if keys, _ := s.GetDKIMKeys("internal", "example.com."); len(keys) != 2 {
+		t.Errorf("no scheduled rotation in view %+v", keys)
+	}
+}
@@ -0,0 +1,181 @@
+package dns
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/miekg/dns"
+)
+
+// ttl of synthesized records without SOA in negative AAAA answer (RFC 6147 section 5.1.7)
+const dns64TTL = 600
+
+// DNS64 synthesis of AAAA records from A records of upstream for clients behind NAT64 (RFC 6147)
+type DNS64 struct {
+	prefix *net.IPNet
+	// AAAA records in ipv6 networks are treated as missing, A records in ipv4 networks are not synthesized
+	ipv6s []*net.IPNet
+	ipv4s []*net.IPNet
+	// names and their sub domains without synthesis
+	names []string
+}
+
+// NewDNS64 parse prefix and exclusions of ipv6 networks, ipv4 networks and domain names
+func NewDNS64(prefix string, exclude []string) (*DNS64, error) {
+
+	network, err := ParseNAT64(prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &DNS64{prefix: network}
+	for _, v := range exclude {
+		v = strings.TrimSpace(v)
+		switch {
+		case v == "":
+		case strings.Contains(v, "/"):
+			_, n, err := net.ParseCIDR(v)
+			if err != nil {
+				return nil, fmt.Errorf("dns64: invalid exclusion %q", v)
+			}
+			// ipv4 mapped networks are ipv6 networks here
+			if _, bits := n.Mask.Size(); bits == 32 {
+				d.ipv4s = append(d.ipv4s, n)
+			} else {
+				d.ipv6s = append(d.ipv6s, n)
+			}
+		default:
+			if _, ok := dns.IsDomainName(v); !ok {
+				return nil, fmt.Errorf("dns64: invalid exclusion %q", v)
+			}
+			d.names = append(d.names, strings.ToLower(dns.Fqdn(v)))
+		}
+	}
+
+	return d, nil
+}
+
+// excluded name without synthesis
+func (d *DNS64) excluded(name string) bool {
+	for _, v := range d.names {
+		if dns.IsSubDomain(v, strings.ToLower(name)) {
+			return true
+		}
+	}
+	return false
+}
+
+// within ip in one of networks
+func within(networks []*net.IPNet, ip net.IP) bool {
+	for _, v := range networks {
+		if v.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// filter remove AAAA records of excluded networks from answer, true when answer needs synthesis:
+// no AAAA records left or failure of upstream, but not for names which do not exist
+func (d *DNS64) filter(msg *dns.Msg) bool {
+
+	switch msg.Rcode {
+	case dns.RcodeServerFailure:
+		return true
+	case dns.RcodeSuccess:
+	default:
+		return false
+	}
+
+	var (
+		answer []dns.RR
+		found  bool
+	)
+	for _, rr := range msg.Answer {
+		if v, ok := rr.(*dns.AAAA); ok {
+			if within(d.ipv6s, v.AAAA) {
+				continue
+			}
+			found = true
+		}
+		answer = append(answer, rr)
+	}
+
+	if found {
+		msg.Answer = answer
+	}
+	return !found
+}
+
+// synthesize answer of AAAA query from answer of A query, nil without A records to synthesize
+func (d *DNS64) synthesize(r, a, negative *dns.Msg) *dns.Msg {
+
+	// synthesized records live no longer than the missing AAAA records
+	max := uint32(dns64TTL)
+	for _, rr := range negative.Ns {
+		if v, ok := rr.(*dns.SOA); ok {
+			max = v.Hdr.Ttl
+			if v.Minttl < max {
+				max = v.Minttl
+			}
+		}
+	}
+
+	msg := &dns.Msg{}
+	msg.SetReply(r)
+	msg.RecursionAvailable = a.RecursionAvailable
+
+	var found bool
+	for _, rr := range a.Answer {
+		switch v := rr.(type) {
+		case *dns.CNAME, *dns.DNAME:
+			msg.Answer = append(msg.Answer, dns.Copy(rr))
+		case *dns.A:
+			if within(d.ipv4s, v.A) {
+				continue
+			}
+			hdr := v.Hdr
+			hdr.Rrtype = dns.TypeAAAA
+			if hdr.Ttl > max {
+				hdr.Ttl = max
+			}
+			msg.Answer = append(msg.Answer, &dns.AAAA{Hdr: hdr, AAAA: embed(d.prefix, v.A)})
+			found = true
+		}
+	}
+	if !found {
+		return nil
+	}
+
+	// client subnet of upstream reply is kept for its scope
+	for _, rr := range a.Extra {
+		if rr.Header().Rrtype == dns.TypeOPT {
+			msg.Extra = append(msg.Extra, dns.Copy(rr))
+		}
+	}
+
+	return msg
+}
+
+// dns64 answer of AAAA query with records synthesized from A records when upstream has no AAAA records;
+// queries of validating clients with checking disabled are not touched
+func (s *DNS) dns64(r, msg *dns.Msg, subnet *net.IPNet, scope uint8) (*dns.Msg, uint8) {
+
+	d := s.DNS64
+	if d == nil || r.CheckingDisabled || d.excluded(r.Question[0].Name) || !d.filter(msg) {
+		return msg, scope
+	}
+
+	req := r.Copy()
+	req.Question[0].Qtype = dns.TypeA
+	a, sc, err := s.forward(req, subnet)
+	if err != nil || a.Rcode != dns.RcodeSuccess {
+		return msg, scope
+	}
+
+	if m := d.synthesize(r, a, msg); m != nil {
+		return m, sc
+	}
+	return msg, scope
+}
@@ -0,0 +1,108 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/miekg/dns"
+)
+
+func TestNewDNS64(t *testing.T) {
+
+	d, err := NewDNS64("", []string{"::ffff:0:0/96", "10.0.0.0/8", "Example.COM"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.prefix.String() != "64:ff9b::/96" || len(d.ipv6s) != 1 || len(d.ipv4s) != 1 {
+		t.Errorf("unexpected dns64 %+v", d)
+	}
+	if !d.excluded("www.example.com.") || d.excluded("example.org.") {
+		t.Error("wrong exclusion of names")
+	}
+
+	for _, v := range [][]string{{"2001:db8::/60"}, {"64:ff9b::/96", "10.0.0.0/33"}, {"64:ff9b::/96", "bad..name"}} {
+		if _, err := NewDNS64(v[0], v[1:]); err == nil {
+			t.Errorf("NewDNS64(%v) without error", v)
+		}
+	}
+}
+
+func TestDNS64(t *testing.T) {
+
+	d, err := NewDNS64("64:ff9b::/96", []string{"::ffff:0:0/96", "10.0.0.0/8", "local.example."})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &DNS{Cache: NewCache(10), DNS64: d}
+
+	query := func(name string, qtype uint16) *dns.Msg {
+		m := &dns.Msg{}
+		m.SetQuestion(name, qtype)
+		return m
+	}
+	// answers of upstream for A queries come from cache
+	upstream := func(name string, rrs ...string) {
+		r := query(name, dns.TypeA)
+		msg := &dns.Msg{}
+		msg.SetReply(r)
+		for _, v := range rrs {
+			rr, err := dns.NewRR(v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			msg.Answer = append(msg.Answer, rr)
+		}
+		s.Cache.Set(r, msg, nil, 0)
+	}
+	upstream("v4.example.", "v4.example. 3600 IN CNAME host.example.", "host.example. 3600 IN A 192.0.2.1", "host.example. 3600 IN A 10.0.0.1")
+	upstream("local.example.", "local.example. 60 IN A 192.0.2.2")
+
+	nodata := func(r *dns.Msg, rrs ...string) *dns.Msg {
+		msg := &dns.Msg{}
+		msg.SetReply(r)
+		soa, _ := dns.NewRR("example. 3600 IN SOA ns.example. admin.example. 1 7200 900 1209600 300")
+		msg.Ns = []dns.RR{soa}
+		for _, v := range rrs {
+			rr, _ := dns.NewRR(v)
+			msg.Answer = append(msg.Answer, rr)
+		}
+		return msg
+	}
+
+	// synthesized from A records outside excluded networks, ttl limited by negative answer
+	r := query("v4.example.", dns.TypeAAAA)
+	msg, _ := s.dns64(r, nodata(r), nil, 0)
+	if len(msg.Answer) != 2 {
+		t.Fatalf("expected cname and one AAAA, got %v", msg.Answer)
+	}
+	if v, ok := msg.Answer[1].(*dns.AAAA); !ok || v.AAAA.String() != "64:ff9b::c000:201" || v.Hdr.Ttl != 300 || v.Hdr.Name != "host.example." {
+		t.Errorf("unexpected AAAA %v", msg.Answer[1])
+	}
+
+	// AAAA records of excluded networks count as missing
+	msg, _ = s.dns64(r, nodata(r, "v4.example. 60 IN AAAA ::ffff:192.0.2.1"), nil, 0)
+	if v, ok := msg.Answer[len(msg.Answer)-1].(*dns.AAAA); !ok || v.AAAA.String() != "64:ff9b::c000:201" {
+		t.Errorf("expected synthesis instead of excluded AAAA, got %v", msg.Answer)
+	}
+
+	// real AAAA records are kept
+	msg, _ = s.dns64(r, nodata(r, "v4.example. 60 IN AAAA 2001:db8::1"), nil, 0)
+	if len(msg.Answer) != 1 || msg.Answer[0].(*dns.AAAA).AAAA.String() != "2001:db8::1" {
+		t.Errorf("expected real AAAA, got %v", msg.Answer)
+	}
+
+	// excluded names, names which do not exist and checking disabled are not synthesized
+	r = query("local.example.", dns.TypeAAAA)
+	if msg, _ = s.dns64(r, nodata(r), nil, 0); len(msg.Answer) != 0 {
+		t.Errorf("excluded name synthesized: %v", msg.Answer)
+	}
+	r = query("v4.example.", dns.TypeAAAA)
+	nx := nodata(r)
+	nx.Rcode = dns.RcodeNameError
+	if msg, _ = s.dns64(r, nx, nil, 0); len(msg.Answer) != 0 {
+		t.Errorf("nxdomain synthesized: %v", msg.Answer)
+	}
+	r.CheckingDisabled = true
+	if msg, _ = s.dns64(r, nodata(r), nil, 0); len(msg.Answer) != 0 {
+		t.Errorf("checking disabled synthesized: %v", msg.Answer)
+	}
+}
@@ -2,66 +2,276 @@ package dns
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/MarlikAlmighty/mdns/internal/config"
 	"github.com/MarlikAlmighty/mdns/internal/data"
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
 	"github.com/miekg/dns"
 	"log"
 	"net"
+	"net/http"
+	"sort"
 	"strings"
 	"time"
 )
 
 // DNS wrapper over dns server
 type DNS struct {
-	TcpServer *dns.Server
-	UdpServer *dns.Server
-	Client    *dns.Client
-	Resolver  *data.ResolvedData
-	Config    *config.Configuration
+	Servers    []*dns.Server
+	DohServers []*http.Server
+	DoqServers []*DoqServer
+	Client     *dns.Client
+	Iterator   *Iterator
+	ACL        *ACL
+	Views      *Views
+	Blocklist  *Blocklist
+	Hosts      *Hosts
+	Cert       *Certificate
+	RRL        *RRL
+	Cookies    *Cookies
+	GeoIP      *GeoIP
+	Health     *Health
+	Balancer   *Balancer
+	Cache      *Cache
+	DNS64      *DNS64
+	DKIM       *DKIM
+	MTASTS     *MTASTS
+	Resolver   *data.ResolvedData
+	Config     *config.Configuration
+	cancel     context.CancelFunc
 }
 
 // New simple constructor
 func New(d *data.ResolvedData, cnf *config.Configuration) *DNS {
-	t, u := &dns.Server{}, &dns.Server{}
 	c := &dns.Client{
 		Net: "udp",
 	}
-	return &DNS{
-		TcpServer: t,
-		UdpServer: u,
+	switch cnf.ResolveMode {
+	case "", "forward", "iterative":
+	default:
+		log.Fatalf("load resolve mode: unknown mode %q, want forward or iterative\n", cnf.ResolveMode)
+	}
+	acl := NewACL()
+	for _, v := range cnf.Acls {
+		md, err := ParseACL(v)
+		if err == nil {
+			err = acl.Set(md)
+		}
+		if err != nil {
+			log.Fatalf("load acl %q: %v\n", v, err)
+		}
+	}
+	// lists of clients refer to access lists by name
+	for _, v := range [][]string{cnf.AllowRecursion, cnf.AllowTransfer, cnf.AllowUpdate, cnf.Dns64Clients, cnf.RrlExempt} {
+		if err := acl.Check(v); err != nil {
+			log.Fatalf("load allowed clients: %v\n", err)
+		}
+	}
+	// listeners of views are addresses of servers on all protocols
+	var bound []string
+	for _, proto := range []string{protoUDP, protoTCP, protoTLS, protoQUIC, protoHTTPS} {
+		addrs, err := ListenAddrs(cnf.Listen, proto, "")
+		if err != nil {
+			log.Fatalf("load listen addresses: %v\n", err)
+		}
+		bound = append(bound, addrs...)
+	}
+	views := NewViews()
+	if err := views.Bind(bound); err != nil {
+		log.Fatalf("load listen addresses: %v\n", err)
+	}
+	for i, v := range cnf.Views {
+		md, err := ParseView(v)
+		if err == nil {
+			// views of configuration are matched in given order
+			md.Order = int64(i)
+			err = views.Set(md)
+		}
+		if err != nil {
+			log.Fatalf("load view %q: %v\n", v, err)
+		}
+	}
+	bl := NewBlocklist()
+	for i, v := range cnf.Blocklists {
+		md, err := ParseBlocklist(v)
+		if err != nil {
+			log.Fatalf("load blocklist %q: %v\n", v, err)
+		}
+		// lists of configuration are checked in given order
+		md.Order = int64(i)
+		// list which failed to load is kept empty until next refresh
+		if err = bl.Set(context.Background(), md); err != nil {
+			log.Printf("[ERR]: load blocklist %v: %v\n", md.Name, err)
+			bl.store(newList(md))
+		}
+	}
+	rrl, err := NewRRL(&models.Rrl{
+		ResponsesPerSecond: cnf.RrlResponsesPerSecond,
+		NxdomainsPerSecond: cnf.RrlNxdomainsPerSecond,
+		ErrorsPerSecond:    cnf.RrlErrorsPerSecond,
+		Window:             cnf.RrlWindow,
+		Slip:               cnf.RrlSlip,
+		IPV4PrefixLength:   cnf.RrlIpv4PrefixLength,
+		IPV6PrefixLength:   cnf.RrlIpv6PrefixLength,
+		Exempt:             cnf.RrlExempt,
+	})
+	if err != nil {
+		log.Fatalf("load rrl: %v\n", err)
+	}
+	cookies, err := NewCookies(cnf.CookieSecret, cnf.CookieRequire)
+	if err != nil {
+		log.Fatalf("load cookies: %v\n", err)
+	}
+	geo := NewGeoIP(cnf.GeoipFiles)
+	if err := geo.Load(); err != nil {
+		log.Printf("[ERR]: load geoip: %v\n", err)
+	}
+	dns64, err := NewDNS64(cnf.Dns64Prefix, cnf.Dns64Exclude)
+	if err != nil {
+		log.Fatalf("load dns64: %v\n", err)
+	}
+	mtaSTS, err := NewMTASTS(cnf.MtaStsAddresses)
+	if err != nil {
+		log.Fatalf("load mta-sts: %v\n", err)
+	}
+	hosts := NewHosts(cnf.HostsFile)
+	if err := hosts.Load(); err != nil {
+		log.Printf("[ERR]: load hosts file: %v\n", err)
+	}
+	s := &DNS{
 		Client:    c,
+		Iterator:  NewIterator(cnf.RootServers, cnf.QnameMinimisation),
+		ACL:       acl,
+		Views:     views,
+		Blocklist: bl,
+		Hosts:     hosts,
+		Cert:      NewCertificate(cnf.TlsCertFile, cnf.TlsKeyFile),
+		RRL:       rrl,
+		Cookies:   cookies,
+		GeoIP:     geo,
+		Health:    NewHealth(),
+		Balancer:  NewBalancer(),
+		Cache:     NewCache(cnf.CacheSize),
+		DNS64:     dns64,
+		DKIM:      NewDKIM(cnf.DkimPublish, cnf.DkimRetire, cnf.DkimRotate),
+		MTASTS:    mtaSTS,
 		Resolver:  d,
 		Config:    cnf,
 	}
+	if cnf.TlsAcme {
+		s.Cert.Manager = NewManager(cnf.TlsAcmeDirectory, cnf.TlsAcmeEmail, cnf.TlsAcmeCacheDir, s.served)
+	}
+	return s
 }
 
 // Run start dns server
 func (s *DNS) Run() {
 
-	tcpHandler := dns.NewServeMux()
-	tcpHandler.HandleFunc(".", s.Handler)
-	udpHandler := dns.NewServeMux()
-	udpHandler.HandleFunc(".", s.Handler)
+	handler := dns.NewServeMux()
+	handler.HandleFunc(".", s.Handler)
 
-	s.TcpServer.Addr = "0.0.0.0" + ":" + s.Config.DnsTcpPort
-	s.TcpServer.Net = "tcp4"
-	s.TcpServer.Handler = tcpHandler
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
 
-	s.UdpServer.Addr = "0.0.0.0" + ":" + s.Config.DnsUdpPort
-	s.UdpServer.Net = "udp4"
-	s.UdpServer.Handler = udpHandler
+	go s.Blocklist.Refresh(ctx, s.Config.BlocklistRefresh)
+	go s.Hosts.Watch(ctx, s.Config.HostsWatch)
+	go s.RRL.Clean(ctx, time.Minute)
+	go s.Cookies.Rotate(ctx, s.Config.CookieRotate)
+	go s.Cache.Clean(ctx, time.Minute)
+	go s.DKIM.Run(ctx, time.Minute, s.zoneSets)
+	go s.Health.Run(ctx, time.Second, s.entries)
 
-	go func() {
-		log.Printf("Serving mdns on tcp %v \n", s.TcpServer.Addr)
-		if err := s.TcpServer.ListenAndServe(); err != nil {
-			log.Fatal(err)
+	secrets := tsigSecrets(s.Config.TsigKeys)
+
+	// one server per address, tcp and udp listen on all ipv4 addresses by default
+	for _, addr := range s.listen(protoTCP, s.Config.DnsTcpPort) {
+		s.serve(&dns.Server{Addr: addr, Net: "tcp", Handler: handler, TsigSecret: secrets})
+	}
+
+	for _, addr := range s.listen(protoUDP, s.Config.DnsUdpPort) {
+		s.serve(&dns.Server{Addr: addr, Net: "udp", Handler: handler, TsigSecret: secrets})
+	}
+
+	// dns over tls, https and quic only with configured ports or addresses
+	tlsAddrs := s.listen(protoTLS, s.Config.DnsTlsPort)
+	dohAddrs := s.listen(protoHTTPS, s.Config.DohPort)
+	quicAddrs := s.listen(protoQUIC, s.Config.DnsQuicPort)
+	if len(tlsAddrs) == 0 && len(dohAddrs) == 0 && len(quicAddrs) == 0 {
+		return
+	}
+
+	if err := s.Cert.Load(); err != nil {
+		log.Fatalf("load tls certificate: %v\n", err)
+	}
+
+	for _, addr := range tlsAddrs {
+		s.serve(s.dotServer(addr, handler, secrets))
+	}
+
+	for _, addr := range dohAddrs {
+		srv := &http.Server{
+			Addr:        addr,
+			Handler:     s.WellKnown(s.DoH(nil)),
+			TLSConfig:   s.Cert.TLSConfig("h2", "http/1.1"),
+			IdleTimeout: s.Config.DnsTlsIdleTimeout,
 		}
-	}()
+		s.DohServers = append(s.DohServers, srv)
+		go func() {
+			log.Printf("Serving mdns on https %v \n", srv.Addr)
+			if err := srv.ListenAndServeTLS("", ""); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				log.Fatal(err)
+			}
+		}()
+	}
+
+	for _, addr := range quicAddrs {
+		srv := &DoqServer{
+			Addr:        addr,
+			Handler:     handler,
+			TLSConfig:   s.Cert.TLSConfig("doq"),
+			IdleTimeout: s.Config.DnsTlsIdleTimeout,
+		}
+		s.DoqServers = append(s.DoqServers, srv)
+		go func() {
+			log.Printf("Serving mdns on quic %v \n", srv.Addr)
+			if err := srv.ListenAndServe(); err != nil {
+				log.Fatal(err)
+			}
+		}()
+	}
+}
+
+// listen addresses of protocol from configuration
+func (s *DNS) listen(proto, port string) []string {
+	addrs, err := ListenAddrs(s.Config.Listen, proto, port)
+	if err != nil {
+		log.Fatalf("%v\n", err)
+	}
+	return addrs
+}
 
+// dotServer server of dns over tls, pipelined queries of connection are answered until idle timeout
+func (s *DNS) dotServer(addr string, handler dns.Handler, secrets map[string]string) *dns.Server {
+	return &dns.Server{
+		Addr:          addr,
+		Net:           "tcp-tls",
+		Handler:       handler,
+		TsigSecret:    secrets,
+		TLSConfig:     s.Cert.TLSConfig("dot"),
+		MaxTCPQueries: s.Config.DnsTlsMaxQueries,
+		IdleTimeout: func() time.Duration {
+			return s.Config.DnsTlsIdleTimeout
+		},
+	}
+}
+
+// serve start dns server in background
+func (s *DNS) serve(srv *dns.Server) {
+	s.Servers = append(s.Servers, srv)
 	go func() {
-		log.Printf("Serving mdns on udp %v \n", s.UdpServer.Addr)
-		if err := s.UdpServer.ListenAndServe(); err != nil {
+		log.Printf("Serving mdns on %v %v \n", srv.Net, srv.Addr)
+		if err := srv.ListenAndServe(); err != nil {
 			log.Fatal(err)
 		}
 	}()
@@ -70,11 +280,7 @@ func (s *DNS) Run() {
 // Handler serve dns requests
 func (s *DNS) Handler(w dns.ResponseWriter, r *dns.Msg) {
 
-	defer func() {
-		if err := w.Close(); err != nil {
-			log.Printf("%v\n", err)
-		}
-	}()
+	// connections of tcp and tls stay open for pipelined queries until idle timeout
 
 	msg := &dns.Msg{}
 	msg.SetReply(r)
@@ -95,94 +301,285 @@ func (s *DNS) Handler(w dns.ResponseWriter, r *dns.Msg) {
 
 	// *******************************************
 
-	header := dns.RR_Header{
-		Name:   msg.Question[0].Name,
-		Rrtype: msg.Question[0].Qtype,
-		Class:  dns.ClassINET,
-		Ttl:    60,
+	// client address and verified tsig key for access lists
+	client := net.ParseIP(host)
+	key := tsigKey(w, r)
+
+	// zones of first matching view, clients of no view see the default zones
+	zones := s.Views.Select(s.ACL, w.LocalAddr(), client, key)
+	if zones == nil {
+		zones = s.Resolver
+	}
+
+	// only version 0 of EDNS is supported
+	if badVersion(r, msg) {
+		s.write(w, r, msg)
+		return
+	}
+
+	// cookies are checked before anything is done for client
+	if rcode := s.Cookies.Check(r, client, udp(w)); rcode != dns.RcodeSuccess {
+		msg.SetRcode(r, rcode)
+		s.write(w, r, msg)
+		return
 	}
+
+	// network of client for answers by subnet, malformed client subnet is refused as format error
+	subnet, err := clientSubnet(r, client)
+	if err != nil {
+		msg.SetRcode(r, dns.RcodeFormatError)
+		s.write(w, r, msg)
+		return
+	}
+
+	// dynamic updates are not supported, but only allowed clients get to know it
+	if r.Opcode == dns.OpcodeUpdate {
+		if s.ACL.Allowed(s.Config.AllowUpdate, client, key) {
+			msg.SetRcode(r, dns.RcodeNotImplemented)
+		} else {
+			log.Printf("[ERR]: deny update from %v\n", host)
+			msg.SetRcode(r, dns.RcodeRefused)
+		}
+		s.write(w, r, msg)
+		return
+	}
+
 	// to lower case
 	domain := strings.ToLower(msg.Question[0].Name)
-	// find domain in map
-	entry := s.Resolver.Get(domain)
-	// find sub domain in map
-	if entry.Domain == "" {
-		mp := s.Resolver.GetMap()
-		for k, v := range mp {
-			if ok := dns.IsSubDomain(k, domain); ok {
-				domain = k
-				entry = &v
-				break
-			}
+	// find domain or closest zone above it
+	entry := closestZone(zones, domain)
+	if entry.Domain != "" {
+		domain = strings.ToLower(entry.Domain)
+	}
+
+	// zone transfers only of own zones and for allowed clients
+	if qtype := r.Question[0].Qtype; qtype == dns.TypeAXFR || qtype == dns.TypeIXFR {
+		if entry.Domain != domain || !s.ACL.Allowed(s.Config.AllowTransfer, client, key) {
+			log.Printf("[ERR]: deny transfer of %v from %v\n", domain, host)
+			msg.SetRcode(r, dns.RcodeRefused)
+			s.write(w, r, msg)
+			return
 		}
+		if err = s.transfer(w, r, entry); err != nil {
+			log.Printf("[ERR]: transfer %v\n", err)
+		}
+		return
 	}
+
+	// scope of answer for client subnet, zero when answer is the same for everybody
+	var scope uint8
+
 	// if domain or sub domain find
 	if entry.Domain != "" {
-		switch r.Question[0].Qtype {
-		case dns.TypeA:
-			s.a(msg, entry, header)
-		case dns.TypeAAAA:
-			s.aaaa(msg, entry, header)
-		case dns.TypeCAA:
-			s.caa(msg, header)
-		case dns.TypeTXT:
-			s.txt(msg, entry)
-		case dns.TypeSOA:
-			s.soa(msg, entry)
-		case dns.TypeNS:
-			s.ns(msg, entry)
-		case dns.TypePTR:
-			s.ptr(msg, entry)
-		case dns.TypeMX:
-			s.mx(msg, entry)
-		default:
-			s.soa(msg, entry)
+		s.answer(msg, entry, subnet, zones)
+		if (len(entry.Subnets) > 0 || len(entry.Geo) > 0) && subnet != nil {
+			ones, _ := subnet.Mask.Size()
+			scope = uint8(ones)
 		}
-
 	} else {
 
 		/*
 			if not found domain on server, doing look up request in internet,
-			but before check if request is not from local net
+			but before check if client is allowed to use recursion
 		*/
-		n := net.ParseIP(host)
-		if n.IsPrivate() || n.IsLoopback() {
-			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-			defer cancel()
-			if msg, err = s.Lookup(ctx, r, s.Config.NameServers); err != nil {
+		allowed := func(groups []string) bool {
+			return s.ACL.Allowed(groups, client, key)
+		}
+		if !allowed(s.Config.AllowRecursion) {
+			log.Printf("[ERR]: deny request from %v\n", host)
+			msg.SetRcode(r, dns.RcodeRefused)
+		} else if m, ok := s.Hosts.Lookup(r); ok {
+			msg = m
+		} else if s.Blocklist.Blocked(domain, allowed) {
+			log.Printf("[BLK]: %v from %v\n", domain, host)
+			msg = block(r, s.Config.BlockResponse)
+		} else {
+			if msg, scope, err = s.forward(r, subnet); err != nil {
 				log.Printf("[ERR]: %v\n", err)
 				return
 			}
-		} else {
-			log.Printf("[ERR]: deny request from %v\n",
-				n.String())
-			return
+			if r.Question[0].Qtype == dns.TypeAAAA && allowed(s.Config.Dns64Clients) {
+				msg, scope = s.dns64(r, msg, subnet, scope)
+			}
 		}
 	}
 
-	if err = w.WriteMsg(msg); err != nil {
-		log.Printf("[ERR]: write msg %v\n", err)
+	withSubnet(r, msg, scope)
+	s.write(w, r, msg)
+}
+
+// closestZone entry of name or of closest zone containing name, the closest zone wins for delegations
+// inside zones; empty entry when name is not ours
+func closestZone(zones *data.ResolvedData, name string) *models.DNSEntry {
+
+	name = strings.ToLower(name)
+	if entry := zones.Get(name); entry.Domain != "" {
+		return entry
 	}
+
+	mp := zones.GetMap()
+	closest := ""
+	for k := range mp {
+		if dns.IsSubDomain(k, name) && dns.CountLabel(k) > dns.CountLabel(closest) {
+			closest = k
+		}
+	}
+	if closest == "" {
+		return &models.DNSEntry{}
+	}
+	v := mp[closest]
+	return &v
 }
 
-func (s *DNS) Lookup(ctx context.Context, req *dns.Msg, nameServers []string) (*dns.Msg, error) {
+// forward answer of cache or of upstream for query from client subnet with scope of answer
+func (s *DNS) forward(r *dns.Msg, subnet *net.IPNet) (*dns.Msg, uint8, error) {
+
+	if msg, scope, ok := s.Cache.Get(r, subnet); ok {
+		return msg, scope, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
 
 	var (
-		r   *dns.Msg
-		err error
+		msg   *dns.Msg
+		scope uint8
+		err   error
 	)
+	if s.Config.ResolveMode == "iterative" {
+		msg, err = s.Iterator.Resolve(ctx, r)
+	} else {
+		req := upstreamSubnet(r, subnet, s.Config.EcsUpstream,
+			s.Config.EcsIpv4PrefixLength, s.Config.EcsIpv6PrefixLength)
+		if msg, err = s.Lookup(ctx, req, s.Config.NameServers); err == nil {
+			scope = replyScope(msg)
+		}
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	s.Cache.Set(r, msg, subnet, scope)
+	return msg, scope, nil
+}
+
+// answer fill message with records of own zone, zones are searched for automatic PTRs of reverse zones
+func (s *DNS) answer(msg *dns.Msg, entry *models.DNSEntry, subnet *net.IPNet, zones *data.ResolvedData) {
+
+	msg.Authoritative = true
+
+	// names below cut are answered by child zone, DS records of cut belong to parent
+	q := msg.Question[0]
+	if d, child := delegated(entry, q.Name); d != nil {
+		if q.Qtype == dns.TypeDS && strings.ToLower(q.Name) == child {
+			s.nodata(msg, entry)
+		} else {
+			s.referral(msg, entry, d, child)
+		}
+		return
+	}
 
+	if isReverse(entry.Domain) {
+		s.reverse(msg, entry, zones)
+		return
+	}
+
+	header := dns.RR_Header{
+		Name:   msg.Question[0].Name,
+		Rrtype: msg.Question[0].Qtype,
+		Class:  dns.ClassINET,
+		Ttl:    60,
+	}
+
+	// name servers with addresses and policy host of MTA-STS answer with their addresses
+	ns := hostAddresses(entry, q.Name)
+	if ns == nil {
+		ns = s.MTASTS.host(entry, q.Name)
+	}
+	if ns != nil && (q.Qtype == dns.TypeA || q.Qtype == dns.TypeAAAA) {
+		s.host(msg, ns, header)
+		if len(msg.Answer) == 0 {
+			s.nodata(msg, entry)
+		}
+		return
+	}
+
+	switch msg.Question[0].Qtype {
+	case dns.TypeA:
+		s.a(msg, entry, header, subnet)
+	case dns.TypeAAAA:
+		s.aaaa(msg, entry, header, subnet)
+	case dns.TypeCAA:
+		s.caa(msg, header)
+	case dns.TypeTXT:
+		s.txt(msg, entry)
+	case dns.TypeSOA:
+		s.soa(msg, entry)
+	case dns.TypeNS:
+		s.ns(msg, entry)
+	case dns.TypePTR:
+		// addresses are named in reverse zones only
+		s.nodata(msg, entry)
+	case dns.TypeMX:
+		s.mx(msg, entry)
+	case dns.TypeSRV:
+		s.srv(msg, entry)
+	default:
+		s.soa(msg, entry)
+	}
+
+	s.additional(msg, entry, subnet, zones)
+}
+
+// write send reply, signed with the key of request; udp replies are rate limited
+// and all replies are truncated to size of transport
+func (s *DNS) write(w dns.ResponseWriter, r *dns.Msg, msg *dns.Msg) {
+	overUDP := udp(w)
+	if overUDP {
+		ip := remoteIP(w)
+		// clients with valid server cookie are not spoofed
+		exempt := func(groups []string) bool {
+			return s.Cookies.Valid(r, ip) || s.ACL.Allowed(groups, ip, tsigKey(w, r))
+		}
+		switch s.RRL.Limit(ip, msg, exempt) {
+		case rrlDrop:
+			return
+		case rrlSlip:
+			msg = slip(r)
+		}
+	}
+	s.edns(r, msg, overUDP, remoteIP(w))
+	if t := r.IsTsig(); t != nil && w.TsigStatus() == nil {
+		msg.SetTsig(t.Hdr.Name, t.Algorithm, 300, time.Now().Unix())
+	}
+	if err := w.WriteMsg(msg); err != nil {
+		log.Printf("[ERR]: write msg %v\n", err)
+	}
+}
+
+func (s *DNS) Lookup(ctx context.Context, req *dns.Msg, nameServers []string) (*dns.Msg, error) {
+
+	req = withoutCookie(req)
 	answer := make(chan *dns.Msg, 1)
 
 	for _, v := range nameServers {
 		go func(v string, answer chan *dns.Msg) {
-			r, _, err = s.Client.Exchange(req, v+":53")
+			r, _, err := s.Client.Exchange(req, v+":53")
+			// truncated answer of upstream is fetched again over tcp
+			if err == nil && r.Truncated {
+				tcp := &dns.Client{Net: "tcp", Timeout: s.Client.Timeout}
+				r, _, err = tcp.Exchange(req, v+":53")
+			}
 			if err != nil {
 				log.Printf("[ERR]: client exchange, host: %v err: %v\n", v, err)
 				return
 			}
+			// only the first answer is used
 			if r != nil {
-				answer <- r
+				select {
+				case answer <- r:
+				default:
+				}
 			}
 		}(v, answer)
 	}
@@ -197,15 +594,31 @@ func (s *DNS) Lookup(ctx context.Context, req *dns.Msg, nameServers []string) (*
 
 // Close stop dns server
 func (s *DNS) Close() error {
+	if s.cancel != nil {
+		s.cancel()
+	}
 	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
 	defer cancel()
 	var errs []string
-	log.Printf("Stopped serving tcp on %v \n", s.TcpServer.Addr)
-	if err := s.TcpServer.ShutdownContext(ctx); err != nil {
-		errs = append(errs, err.Error())
+	for _, srv := range s.Servers {
+		log.Printf("Stopped serving %v on %v \n", srv.Net, srv.Addr)
+		if err := srv.ShutdownContext(ctx); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	for _, srv := range s.DohServers {
+		log.Printf("Stopped serving https on %v \n", srv.Addr)
+		if err := srv.Shutdown(ctx); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	for _, srv := range s.DoqServers {
+		log.Printf("Stopped serving quic on %v \n", srv.Addr)
+		if err := srv.Shutdown(ctx); err != nil {
+			errs = append(errs, err.Error())
+		}
 	}
-	log.Printf("Stopped serving udp on %v \n", s.UdpServer.Addr)
-	if err := s.UdpServer.ShutdownContext(ctx); err != nil {
+	if err := s.GeoIP.Close(); err != nil {
 		errs = append(errs, err.Error())
 	}
 	if len(errs) > 0 {
@@ -214,16 +627,225 @@ func (s *DNS) Close() error {
 	return nil
 }
 
-// reverseIP reverse ipv4 address for ptr
-func (s *DNS) reverseIP(ip net.IP) string {
-	if ip.To4() != nil {
-		addressSlice := strings.Split(ip.String(), ".")
-		var reverseSlice []string
-		for i := range addressSlice {
-			octet := addressSlice[len(addressSlice)-1-i]
-			reverseSlice = append(reverseSlice, octet)
+// SetACL add or replace access list
+func (s *DNS) SetACL(md *models.ACL) error {
+	return s.ACL.Set(md)
+}
+
+// GetACL fetch access list by name
+func (s *DNS) GetACL(name string) *models.ACL {
+	return s.ACL.Get(name)
+}
+
+// DeleteACL delete access list by name
+func (s *DNS) DeleteACL(name string) error {
+	return s.ACL.Delete(name)
+}
+
+// GetACLs get all access lists
+func (s *DNS) GetACLs() map[string]models.ACL {
+	return s.ACL.GetMap()
+}
+
+// SetView add or replace view
+func (s *DNS) SetView(md *models.View) error {
+	return s.Views.Set(md)
+}
+
+// GetView fetch view by name
+func (s *DNS) GetView(name string) *models.View {
+	return s.Views.Get(name)
+}
+
+// DeleteView delete view with its zones by name
+func (s *DNS) DeleteView(name string) error {
+	return s.Views.Delete(name)
+}
+
+// GetViews get all views
+func (s *DNS) GetViews() map[string]models.View {
+	return s.Views.GetMap()
+}
+
+// GetViewZones zones of view by name
+func (s *DNS) GetViewZones(name string) (*data.ResolvedData, error) {
+	return s.Views.Zones(name)
+}
+
+// SetBlocklist load and add or replace block list
+func (s *DNS) SetBlocklist(md *models.Blocklist) error {
+	return s.Blocklist.Set(context.Background(), md)
+}
+
+// GetBlocklist fetch block list by name
+func (s *DNS) GetBlocklist(name string) *models.Blocklist {
+	return s.Blocklist.Get(name)
+}
+
+// DeleteBlocklist delete block list by name
+func (s *DNS) DeleteBlocklist(name string) error {
+	return s.Blocklist.Delete(name)
+}
+
+// GetBlocklists get all block lists with hit counts
+func (s *DNS) GetBlocklists() map[string]models.Blocklist {
+	return s.Blocklist.GetMap()
+}
+
+// SetOverride add or replace static override of name
+func (s *DNS) SetOverride(md *models.Override) error {
+	return s.Hosts.Set(md)
+}
+
+// GetOverride fetch static override by name
+func (s *DNS) GetOverride(name string) *models.Override {
+	return s.Hosts.Get(name)
+}
+
+// DeleteOverride delete static override by name
+func (s *DNS) DeleteOverride(name string) error {
+	return s.Hosts.Delete(name)
+}
+
+// GetOverrides get all static overrides
+func (s *DNS) GetOverrides() map[string]models.Override {
+	return s.Hosts.GetMap()
+}
+
+// SetRRL change given settings of response rate limiting, exempted clients refer to known access lists
+func (s *DNS) SetRRL(md *models.RrlUpdate) error {
+	if err := s.ACL.Check(md.Exempt); err != nil {
+		return err
+	}
+	return s.RRL.Update(md)
+}
+
+// GetRRL settings and counters of response rate limiting
+func (s *DNS) GetRRL() *models.Rrl {
+	return s.RRL.Get()
+}
+
+// entries all dns entries of default zones and of views
+func (s *DNS) entries() []models.DNSEntry {
+	var list []models.DNSEntry
+	for _, v := range s.Resolver.GetMap() {
+		list = append(list, v)
+	}
+	for name := range s.Views.GetMap() {
+		if zones, err := s.Views.Zones(name); err == nil {
+			for _, v := range zones.GetMap() {
+				list = append(list, v)
+			}
 		}
-		return strings.Join(reverseSlice, ".")
 	}
-	return ""
+	return list
+}
+
+// zoneSets default zones and zones of every view by name
+func (s *DNS) zoneSets() []*data.ResolvedData {
+	sets := []*data.ResolvedData{s.Resolver}
+	if s.Views == nil {
+		return sets
+	}
+	views := s.Views.GetMap()
+	names := make([]string, 0, len(views))
+	for name := range views {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if zones, err := s.Views.Zones(name); err == nil {
+			sets = append(sets, zones)
+		}
+	}
+	return sets
+}
+
+// zonesOf zones of view, default zones without view
+func (s *DNS) zonesOf(view string) (*data.ResolvedData, error) {
+	if view == "" {
+		return s.Resolver, nil
+	}
+	return s.Views.Zones(view)
+}
+
+// GetHealth status of health checks of dns entry
+func (s *DNS) GetHealth(domain string) models.HealthStatusList {
+	return s.Health.Get(domain)
+}
+
+// GetHealthMap status of health checks of all dns entries
+func (s *DNS) GetHealthMap() models.HealthStatuses {
+	return s.Health.GetMap()
+}
+
+// GetDKIMKeys DKIM keys of zone of view without private keys
+func (s *DNS) GetDKIMKeys(view, domain string) (models.DkimKeys, error) {
+	zones, err := s.zonesOf(view)
+	if err != nil {
+		return nil, err
+	}
+	return s.DKIM.Keys(zones, domain)
+}
+
+// AddDKIMKey add DKIM key with selector to zone of view
+func (s *DNS) AddDKIMKey(view, domain string, md *models.DkimKey) (*models.DkimKey, error) {
+	zones, err := s.zonesOf(view)
+	if err != nil {
+		return nil, err
+	}
+	return s.DKIM.Add(zones, domain, md)
+}
+
+// RotateDKIMKey publish new DKIM key of zone of view which signs after publish delay
+func (s *DNS) RotateDKIMKey(view, domain string, md *models.DkimKey) (*models.DkimKey, error) {
+	zones, err := s.zonesOf(view)
+	if err != nil {
+		return nil, err
+	}
+	return s.DKIM.Rotate(zones, domain, md)
+}
+
+// RevokeDKIMKey revoke DKIM key of zone of view
+func (s *DNS) RevokeDKIMKey(view, domain, selector string) error {
+	zones, err := s.zonesOf(view)
+	if err != nil {
+		return err
+	}
+	return s.DKIM.Revoke(zones, domain, selector)
+}
+
+// ExportDKIMKey private DKIM key of zone of view in PEM format
+func (s *DNS) ExportDKIMKey(view, domain, selector string) (*models.DkimPem, error) {
+	zones, err := s.zonesOf(view)
+	if err != nil {
+		return nil, err
+	}
+	return s.DKIM.Export(zones, domain, selector)
+}
+
+// Preview addresses of default zones which client address or network would receive for domain
+func (s *DNS) Preview(domain, client string) (*models.Preview, error) {
+
+	subnet, err := parseClient(client)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := s.Resolver.Get(strings.ToLower(dns.Fqdn(domain)))
+	if entry.Domain == "" {
+		return nil, errors.New("domain does not exist")
+	}
+
+	loc := s.GeoIP.Lookup(subnet.IP)
+	ipv4s, ipv6s := s.addresses(entry, subnet)
+	return &models.Preview{
+		Domain:    entry.Domain,
+		IP:        subnet.String(),
+		Continent: loc.Continent.Code,
+		Country:   loc.Country.ISOCode,
+		Asn:       int64(loc.ASN),
+		Ipv4s:     ipv4s,
+		Ipv6s:     ipv6s,
+	}, nil
 }
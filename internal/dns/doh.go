@@ -0,0 +1,266 @@
+package dns
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/miekg/dns"
+)
+
+// paths and content types of dns over https
+const (
+	dohPath       = "/dns-query"
+	dohJSONPath   = "/resolve"
+	dohMessage    = "application/dns-message"
+	dohJSON       = "application/dns-json"
+	dohMaxMessage = 65535
+)
+
+// dohWriter adapter of http request to dns.ResponseWriter
+type dohWriter struct {
+	local  net.Addr
+	remote net.Addr
+	msg    *dns.Msg
+}
+
+// LocalAddr address of http server, always stream transport
+func (w *dohWriter) LocalAddr() net.Addr { return w.local }
+
+// RemoteAddr address of http client
+func (w *dohWriter) RemoteAddr() net.Addr { return w.remote }
+
+// WriteMsg keep reply for http response
+func (w *dohWriter) WriteMsg(m *dns.Msg) error {
+	w.msg = m
+	return nil
+}
+
+// Write keep packed reply for http response
+func (w *dohWriter) Write(b []byte) (int, error) {
+	m := &dns.Msg{}
+	if err := m.Unpack(b); err != nil {
+		return 0, err
+	}
+	w.msg = m
+	return len(b), nil
+}
+
+// Close nothing to close, http server owns connection
+func (w *dohWriter) Close() error { return nil }
+
+// TsigStatus requests over https are not signed
+func (w *dohWriter) TsigStatus() error { return nil }
+
+// TsigTimersOnly not used over https
+func (w *dohWriter) TsigTimersOnly(bool) {}
+
+// Hijack not used over https
+func (w *dohWriter) Hijack() {}
+
+// DoH handler of dns over https (RFC 8484) and json api, other paths go to next
+func (s *DNS) DoH(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case dohPath, dohJSONPath:
+			s.ServeDoH(w, req)
+		default:
+			if next == nil {
+				http.NotFound(w, req)
+				return
+			}
+			next.ServeHTTP(w, req)
+		}
+	})
+}
+
+// ServeDoH answer on query in wire format (GET ?dns=, POST application/dns-message)
+// or in json format (GET ?name=&type=)
+func (s *DNS) ServeDoH(w http.ResponseWriter, req *http.Request) {
+
+	var (
+		r    *dns.Msg
+		err  error
+		json bool
+	)
+
+	switch {
+	case req.Method == http.MethodGet && req.URL.Query().Get("dns") != "":
+		r, err = dohUnpack(base64.RawURLEncoding.DecodeString(req.URL.Query().Get("dns")))
+	case req.Method == http.MethodPost && strings.HasPrefix(req.Header.Get("Content-Type"), dohMessage):
+		r, err = dohUnpack(io.ReadAll(io.LimitReader(req.Body, dohMaxMessage)))
+	case req.Method == http.MethodGet && req.URL.Query().Get("name") != "":
+		r, err = dohQuestion(req.URL.Query().Get("name"), req.URL.Query().Get("type"))
+		json = true
+	default:
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	dw := &dohWriter{
+		local:  &net.TCPAddr{IP: net.IPv4zero},
+		remote: dohRemote(req),
+	}
+
+	s.Handler(dw, r)
+
+	if dw.msg == nil {
+		http.Error(w, "no answer", http.StatusServiceUnavailable)
+		return
+	}
+
+	// lowest ttl of answer is the lifetime of http response
+	w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", minTTL(dw.msg)))
+
+	if json {
+		w.Header().Set("Content-Type", dohJSON)
+		if err = writeJSON(w, dw.msg); err != nil {
+			log.Printf("[ERR]: write doh json %v\n", err)
+		}
+		return
+	}
+
+	// id of get requests should be zero, reply gets id of request
+	b, err := dw.msg.Pack()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", dohMessage)
+	if _, err = w.Write(b); err != nil {
+		log.Printf("[ERR]: write doh msg %v\n", err)
+	}
+}
+
+// dohUnpack unpack query in wire format
+func dohUnpack(b []byte, err error) (*dns.Msg, error) {
+	if err != nil {
+		return nil, err
+	}
+	r := &dns.Msg{}
+	if err = r.Unpack(b); err != nil {
+		return nil, err
+	}
+	if len(r.Question) != 1 {
+		return nil, errors.New("doh: query must have one question")
+	}
+	return r, nil
+}
+
+// dohQuestion build query from name and type, type can be a name or a number
+func dohQuestion(name, qtype string) (*dns.Msg, error) {
+
+	t := dns.TypeA
+	if qtype != "" {
+		if v, ok := dns.StringToType[strings.ToUpper(qtype)]; ok {
+			t = v
+		} else if n, err := strconv.ParseUint(qtype, 10, 16); err == nil {
+			t = uint16(n)
+		} else {
+			return nil, fmt.Errorf("doh: unknown type %q", qtype)
+		}
+	}
+
+	if _, ok := dns.IsDomainName(name); !ok {
+		return nil, fmt.Errorf("doh: invalid name %q", name)
+	}
+
+	r := &dns.Msg{}
+	r.SetQuestion(dns.Fqdn(name), t)
+	return r, nil
+}
+
+// dohRemote address of http client
+func dohRemote(req *http.Request) net.Addr {
+	host, port, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return &net.TCPAddr{}
+	}
+	p, _ := strconv.Atoi(port)
+	return &net.TCPAddr{IP: net.ParseIP(host), Port: p}
+}
+
+// minTTL lowest ttl of records in reply
+func minTTL(m *dns.Msg) uint32 {
+	var ttl uint32
+	first := true
+	for _, rr := range append(append([]dns.RR{}, m.Answer...), m.Ns...) {
+		if first || rr.Header().Ttl < ttl {
+			ttl, first = rr.Header().Ttl, false
+		}
+	}
+	return ttl
+}
+
+// jsonQuestion question of json api
+type jsonQuestion struct {
+	Name string `json:"name"`
+	Type uint16 `json:"type"`
+}
+
+// jsonRR record of json api
+type jsonRR struct {
+	Name string `json:"name"`
+	Type uint16 `json:"type"`
+	TTL  uint32 `json:"TTL"`
+	Data string `json:"data"`
+}
+
+// jsonMsg reply of json api compatible with google and cloudflare resolvers
+type jsonMsg struct {
+	Status    int            `json:"Status"`
+	TC        bool           `json:"TC"`
+	RD        bool           `json:"RD"`
+	RA        bool           `json:"RA"`
+	AD        bool           `json:"AD"`
+	CD        bool           `json:"CD"`
+	Question  []jsonQuestion `json:"Question"`
+	Answer    []jsonRR       `json:"Answer,omitempty"`
+	Authority []jsonRR       `json:"Authority,omitempty"`
+}
+
+// writeJSON write reply in json format
+func writeJSON(w io.Writer, m *dns.Msg) error {
+
+	out := jsonMsg{
+		Status: m.Rcode,
+		TC:     m.Truncated,
+		RD:     m.RecursionDesired,
+		RA:     m.RecursionAvailable,
+		AD:     m.AuthenticatedData,
+		CD:     m.CheckingDisabled,
+	}
+
+	for _, q := range m.Question {
+		out.Question = append(out.Question, jsonQuestion{Name: q.Name, Type: q.Qtype})
+	}
+
+	convert := func(rrs []dns.RR) []jsonRR {
+		var list []jsonRR
+		for _, rr := range rrs {
+			h := rr.Header()
+			// data is the presentation format without header
+			data := strings.TrimPrefix(rr.String(), h.String())
+			list = append(list, jsonRR{Name: h.Name, Type: h.Rrtype, TTL: h.Ttl, Data: data})
+		}
+		return list
+	}
+
+	out.Answer = convert(m.Answer)
+	out.Authority = convert(m.Ns)
+
+	return json.NewEncoder(w).Encode(out)
+}
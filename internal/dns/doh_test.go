@@ -0,0 +1,107 @@
+package dns
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/MarlikAlmighty/mdns/internal/config"
+	"github.com/MarlikAlmighty/mdns/internal/data"
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	"github.com/miekg/dns"
+)
+
+func TestDNS_ServeDoH(t *testing.T) {
+
+	d := data.New()
+	d.Set("example.com.", &models.DNSEntry{Domain: "example.com.", Ipv4s: []string{"192.0.2.1"}})
+	s := New(d, &config.Configuration{})
+
+	srv := httptest.NewServer(s.DoH(http.NotFoundHandler()))
+	defer srv.Close()
+
+	r := &dns.Msg{}
+	r.SetQuestion("www.example.com.", dns.TypeA)
+	r.Id = 0
+	b, err := r.Pack()
+	if err != nil {
+		t.Fatalf("Pack() error = %v", err)
+	}
+
+	wire := func(t *testing.T, resp *http.Response) {
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != dohMessage {
+			t.Fatalf("response %v %v", resp.Status, resp.Header.Get("Content-Type"))
+		}
+		buf := new(bytes.Buffer)
+		if _, err := buf.ReadFrom(resp.Body); err != nil {
+			t.Fatalf("read body: %v", err)
+		}
+		m := &dns.Msg{}
+		if err := m.Unpack(buf.Bytes()); err != nil {
+			t.Fatalf("Unpack() error = %v", err)
+		}
+		if len(m.Answer) != 1 || m.Answer[0].(*dns.A).A.String() != "192.0.2.1" {
+			t.Errorf("answer = %v", m.Answer)
+		}
+		if resp.Header.Get("Cache-Control") != "max-age=60" {
+			t.Errorf("Cache-Control = %v", resp.Header.Get("Cache-Control"))
+		}
+	}
+
+	t.Run("get", func(t *testing.T) {
+		resp, err := http.Get(srv.URL + dohPath + "?dns=" + base64.RawURLEncoding.EncodeToString(b))
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		wire(t, resp)
+	})
+
+	t.Run("post", func(t *testing.T) {
+		resp, err := http.Post(srv.URL+dohPath, dohMessage, bytes.NewReader(b))
+		if err != nil {
+			t.Fatalf("Post() error = %v", err)
+		}
+		wire(t, resp)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		resp, err := http.Get(srv.URL + dohJSONPath + "?name=www.example.com&type=A")
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		defer resp.Body.Close()
+		var out jsonMsg
+		if err = json.NewDecoder(resp.Body).Decode(&out); err != nil {
+			t.Fatalf("Decode() error = %v", err)
+		}
+		if out.Status != dns.RcodeSuccess || len(out.Answer) != 1 || out.Answer[0].Data != "192.0.2.1" {
+			t.Errorf("json = %+v", out)
+		}
+	})
+
+	t.Run("bad_request", func(t *testing.T) {
+		resp, err := http.Get(srv.URL + dohPath + "?dns=!!!")
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("status = %v, want 400", resp.StatusCode)
+		}
+	})
+
+	t.Run("other_path", func(t *testing.T) {
+		resp, err := http.Get(srv.URL + "/dns")
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("status = %v, want 404", resp.StatusCode)
+		}
+	})
+}
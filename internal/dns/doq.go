@@ -0,0 +1,235 @@
+package dns
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"io"
+	"log"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/quic-go/quic-go"
+)
+
+// error codes of dns over quic (RFC 9250)
+const (
+	doqNoError       = 0x0
+	doqInternalError = 0x1
+	doqProtocolError = 0x2
+)
+
+// doqReadTimeout time for client to send whole query on a stream
+const doqReadTimeout = 5 * time.Second
+
+var errDoqStarted = errors.New("doq: server already started")
+
+// DoqServer dns over quic server, one query and one reply per stream
+type DoqServer struct {
+	Addr              string
+	TLSConfig         *tls.Config
+	Handler           dns.Handler
+	IdleTimeout       time.Duration
+	NotifyStartedFunc func()
+	listener          *quic.Listener
+	cancel            context.CancelFunc
+	conns             sync.WaitGroup
+	mux               sync.Mutex
+}
+
+// ListenAndServe listen on udp address and serve connections until shutdown
+func (d *DoqServer) ListenAndServe() error {
+
+	d.mux.Lock()
+	if d.listener != nil {
+		d.mux.Unlock()
+		return errDoqStarted
+	}
+
+	tlsConf := d.TLSConfig.Clone()
+	tlsConf.NextProtos = []string{"doq"}
+	tlsConf.MinVersion = tls.VersionTLS13
+
+	ln, err := quic.ListenAddr(d.Addr, tlsConf, &quic.Config{
+		MaxIdleTimeout: d.IdleTimeout,
+	})
+	if err != nil {
+		d.mux.Unlock()
+		return err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	d.listener, d.cancel = ln, cancel
+	d.mux.Unlock()
+
+	if d.NotifyStartedFunc != nil {
+		d.NotifyStartedFunc()
+	}
+
+	for {
+		conn, err := ln.Accept(context.Background())
+		if err != nil {
+			if errors.Is(err, quic.ErrServerClosed) {
+				return nil
+			}
+			return err
+		}
+		d.conns.Add(1)
+		go d.serveConn(ctx, conn)
+	}
+}
+
+// LocalAddr address of listener, nil before start
+func (d *DoqServer) LocalAddr() net.Addr {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	if d.listener == nil {
+		return nil
+	}
+	return d.listener.Addr()
+}
+
+// Shutdown close listener and wait for connections until context is done
+func (d *DoqServer) Shutdown(ctx context.Context) error {
+
+	d.mux.Lock()
+	ln, cancel := d.listener, d.cancel
+	d.mux.Unlock()
+	if ln == nil {
+		return nil
+	}
+
+	// open connections are closed after their current streams
+	cancel()
+
+	if err := ln.Close(); err != nil {
+		return err
+	}
+
+	done := make(chan struct{})
+	go func() {
+		d.conns.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// serveConn serve streams of connection until client, idle timeout or shutdown closes it
+func (d *DoqServer) serveConn(ctx context.Context, conn quic.Connection) {
+
+	var streams sync.WaitGroup
+
+	defer func() {
+		streams.Wait()
+		_ = conn.CloseWithError(doqNoError, "")
+		d.conns.Done()
+	}()
+
+	for {
+		stream, err := conn.AcceptStream(ctx)
+		if err != nil {
+			return
+		}
+		streams.Add(1)
+		go func() {
+			defer streams.Done()
+			d.serveStream(conn, stream)
+		}()
+	}
+}
+
+// serveStream read query with length prefix, reply on same stream
+func (d *DoqServer) serveStream(conn quic.Connection, stream quic.Stream) {
+
+	if err := stream.SetReadDeadline(time.Now().Add(doqReadTimeout)); err != nil {
+		log.Printf("[ERR]: doq deadline %v\n", err)
+	}
+
+	var length uint16
+	if err := binary.Read(stream, binary.BigEndian, &length); err != nil {
+		stream.CancelRead(doqProtocolError)
+		stream.CancelWrite(doqProtocolError)
+		return
+	}
+
+	b := make([]byte, length)
+	if _, err := io.ReadFull(stream, b); err != nil {
+		stream.CancelRead(doqProtocolError)
+		stream.CancelWrite(doqProtocolError)
+		return
+	}
+
+	r := &dns.Msg{}
+	// id of query must be zero, otherwise the connection is closed
+	if err := r.Unpack(b); err != nil || r.Id != 0 || len(r.Question) != 1 {
+		_ = conn.CloseWithError(doqProtocolError, "invalid query")
+		return
+	}
+
+	w := &doqWriter{conn: conn, stream: stream}
+	d.Handler.ServeDNS(w, r)
+
+	// handler gave no answer, e.g. upstream did not reply
+	if !w.written {
+		stream.CancelWrite(doqInternalError)
+	}
+}
+
+// doqWriter adapter of quic stream to dns.ResponseWriter
+type doqWriter struct {
+	conn    quic.Connection
+	stream  quic.Stream
+	written bool
+}
+
+// LocalAddr address of server
+func (w *doqWriter) LocalAddr() net.Addr { return w.conn.LocalAddr() }
+
+// RemoteAddr address of client
+func (w *doqWriter) RemoteAddr() net.Addr { return w.conn.RemoteAddr() }
+
+// WriteMsg write reply with id zero and close stream
+func (w *doqWriter) WriteMsg(m *dns.Msg) error {
+	m.Id = 0
+	b, err := m.Pack()
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+// Write write packed reply with length prefix and close stream
+func (w *doqWriter) Write(b []byte) (int, error) {
+	if w.written {
+		return 0, errors.New("doq: reply already written")
+	}
+	w.written = true
+	buf := make([]byte, 2+len(b))
+	binary.BigEndian.PutUint16(buf, uint16(len(b)))
+	copy(buf[2:], b)
+	if _, err := w.stream.Write(buf); err != nil {
+		return 0, err
+	}
+	return len(b), w.stream.Close()
+}
+
+// Close close stream for writing
+func (w *doqWriter) Close() error { return w.stream.Close() }
+
+// TsigStatus queries over quic are not signed
+func (w *doqWriter) TsigStatus() error { return nil }
+
+// TsigTimersOnly not used over quic
+func (w *doqWriter) TsigTimersOnly(bool) {}
+
+// Hijack not used over quic
+func (w *doqWriter) Hijack() {}
@@ -0,0 +1,112 @@
+package dns
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"io"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/MarlikAlmighty/mdns/internal/config"
+	"github.com/MarlikAlmighty/mdns/internal/data"
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	"github.com/miekg/dns"
+	"github.com/quic-go/quic-go"
+)
+
+func TestDoqServer(t *testing.T) {
+
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	writeCert(t, certFile, keyFile, "localhost", time.Now())
+
+	d := data.New()
+	d.Set("example.com.", &models.DNSEntry{Domain: "example.com.", Ipv4s: []string{"192.0.2.1"}})
+	s := New(d, &config.Configuration{})
+	s.Cert = NewCertificate(certFile, keyFile)
+
+	started := make(chan struct{})
+	srv := &DoqServer{
+		Addr:              "127.0.0.1:0",
+		TLSConfig:         s.Cert.TLSConfig("doq"),
+		Handler:           dns.HandlerFunc(s.Handler),
+		NotifyStartedFunc: func() { close(started) },
+	}
+	go func() {
+		if err := srv.ListenAndServe(); err != nil {
+			t.Errorf("ListenAndServe() error = %v", err)
+		}
+	}()
+	<-started
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		if err := srv.Shutdown(ctx); err != nil {
+			t.Errorf("Shutdown() error = %v", err)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := quic.DialAddr(ctx, srv.LocalAddr().String(),
+		&tls.Config{InsecureSkipVerify: true, NextProtos: []string{"doq"}}, nil)
+	if err != nil {
+		t.Fatalf("DialAddr() error = %v", err)
+	}
+	defer conn.CloseWithError(doqNoError, "")
+
+	// query sends message with length prefix and closes its side of stream
+	query := func(t *testing.T, r *dns.Msg) (*dns.Msg, error) {
+		stream, err := conn.OpenStreamSync(ctx)
+		if err != nil {
+			t.Fatalf("OpenStreamSync() error = %v", err)
+		}
+		b, err := r.Pack()
+		if err != nil {
+			t.Fatalf("Pack() error = %v", err)
+		}
+		if err = binary.Write(stream, binary.BigEndian, uint16(len(b))); err != nil {
+			t.Fatalf("write length: %v", err)
+		}
+		if _, err = stream.Write(b); err != nil {
+			t.Fatalf("write query: %v", err)
+		}
+		if err = stream.Close(); err != nil {
+			t.Fatalf("close stream: %v", err)
+		}
+		reply, err := io.ReadAll(stream)
+		if err != nil {
+			return nil, err
+		}
+		if len(reply) < 2 || int(binary.BigEndian.Uint16(reply)) != len(reply)-2 {
+			t.Fatalf("reply length %v", len(reply))
+		}
+		m := &dns.Msg{}
+		return m, m.Unpack(reply[2:])
+	}
+
+	// several streams on one connection
+	for _, name := range []string{"example.com.", "www.example.com."} {
+		r := &dns.Msg{}
+		r.SetQuestion(name, dns.TypeA)
+		r.Id = 0
+		m, err := query(t, r)
+		if err != nil {
+			t.Fatalf("query %v error = %v", name, err)
+		}
+		if m.Id != 0 || len(m.Answer) != 1 || m.Answer[0].(*dns.A).A.String() != "192.0.2.1" {
+			t.Errorf("reply of %v = %v", name, m)
+		}
+	}
+
+	// query with id other than zero is a protocol error
+	r := &dns.Msg{}
+	r.SetQuestion("example.com.", dns.TypeA)
+	r.Id = 1
+	if _, err = query(t, r); err == nil {
+		t.Errorf("query with id 1 got reply")
+	}
+}
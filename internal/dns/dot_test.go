@@ -0,0 +1,94 @@
+package dns
+
+import (
+	"crypto/tls"
+	"errors"
+	"io"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/MarlikAlmighty/mdns/internal/config"
+	"github.com/MarlikAlmighty/mdns/internal/data"
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	"github.com/miekg/dns"
+)
+
+func TestDotServer(t *testing.T) {
+
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	writeCert(t, certFile, keyFile, "localhost", time.Now())
+
+	d := data.New()
+	d.Set("example.com.", &models.DNSEntry{Domain: "example.com.", Ipv4s: []string{"192.0.2.1"}})
+	s := New(d, &config.Configuration{
+		TlsCertFile:       certFile,
+		TlsKeyFile:        keyFile,
+		DnsTlsIdleTimeout: 200 * time.Millisecond,
+		DnsTlsMaxQueries:  128,
+	})
+
+	started := make(chan struct{})
+	srv := s.dotServer("127.0.0.1:0", dns.HandlerFunc(s.Handler), nil)
+	srv.NotifyStartedFunc = func() { close(started) }
+	go func() {
+		if err := srv.ListenAndServe(); err != nil {
+			t.Errorf("ListenAndServe() error = %v", err)
+		}
+	}()
+	<-started
+	defer func() {
+		if err := srv.Shutdown(); err != nil {
+			t.Errorf("Shutdown() error = %v", err)
+		}
+	}()
+
+	conn, err := tls.Dial("tcp", srv.Listener.Addr().String(),
+		&tls.Config{InsecureSkipVerify: true, NextProtos: []string{"dot"}})
+	if err != nil {
+		t.Fatalf("Dial() error = %v", err)
+	}
+	defer conn.Close()
+	if got := conn.ConnectionState().NegotiatedProtocol; got != "dot" {
+		t.Errorf("NegotiatedProtocol = %q, want dot", got)
+	}
+	c := &dns.Conn{Conn: conn}
+	if err = c.SetDeadline(time.Now().Add(5 * time.Second)); err != nil {
+		t.Fatal(err)
+	}
+
+	// pipelined queries are all answered on the connection, in any order
+	names := map[uint16]string{1: "example.com.", 2: "www.example.com.", 3: "mail.example.com."}
+	for id, name := range names {
+		r := &dns.Msg{}
+		r.SetQuestion(name, dns.TypeA)
+		r.Id = id
+		if err = c.WriteMsg(r); err != nil {
+			t.Fatalf("WriteMsg(%v) error = %v", name, err)
+		}
+	}
+	for i := len(names); i > 0; i-- {
+		m, err := c.ReadMsg()
+		if err != nil {
+			t.Fatalf("ReadMsg() error = %v", err)
+		}
+		name, ok := names[m.Id]
+		if !ok || len(m.Question) != 1 || m.Question[0].Name != name {
+			t.Fatalf("reply of unknown query %v", m)
+		}
+		delete(names, m.Id)
+		if len(m.Answer) != 1 || m.Answer[0].(*dns.A).A.String() != "192.0.2.1" {
+			t.Errorf("reply of %v = %v", name, m)
+		}
+	}
+
+	// idle connection is closed by server before deadline of client
+	start := time.Now()
+	if _, err = c.ReadMsg(); !errors.Is(err, io.EOF) {
+		t.Fatalf("ReadMsg() of idle connection error = %v, want closed connection", err)
+	}
+	if idle := time.Since(start); idle > 2*time.Second {
+		t.Errorf("idle connection closed after %v", idle)
+	}
+}
@@ -0,0 +1,203 @@
+package dns
+
+import (
+	"errors"
+	"net"
+	"strings"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	"github.com/miekg/dns"
+)
+
+// modes of EDNS client subnet in upstream queries
+const (
+	ecsOff = "off"
+	ecsAdd = "add"
+)
+
+var errSubnet = errors.New("ecs: invalid client subnet")
+
+// ecsOption client subnet option of message, nil without it
+func ecsOption(m *dns.Msg) *dns.EDNS0_SUBNET {
+	opt := m.IsEdns0()
+	if opt == nil {
+		return nil
+	}
+	for _, o := range opt.Option {
+		if v, ok := o.(*dns.EDNS0_SUBNET); ok {
+			return v
+		}
+	}
+	return nil
+}
+
+// clientSubnet network of client from client subnet of query, otherwise address of client;
+// error for malformed option, which is answered with FORMERR
+func clientSubnet(r *dns.Msg, ip net.IP) (*net.IPNet, error) {
+
+	e := ecsOption(r)
+	if e == nil {
+		if ip == nil {
+			return nil, nil
+		}
+		if ip4 := ip.To4(); ip4 != nil {
+			return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}, nil
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
+	}
+
+	bits := 32
+	addr := e.Address.To4()
+	if e.Family == 2 {
+		bits, addr = 128, e.Address.To16()
+	} else if e.Family != 1 {
+		return nil, errSubnet
+	}
+
+	if addr == nil || int(e.SourceNetmask) > bits || e.SourceScope != 0 {
+		return nil, errSubnet
+	}
+
+	// bits of address after source prefix must be zero
+	mask := net.CIDRMask(int(e.SourceNetmask), bits)
+	if !addr.Mask(mask).Equal(addr) {
+		return nil, errSubnet
+	}
+
+	return &net.IPNet{IP: addr, Mask: mask}, nil
+}
+
+// withSubnet put client subnet of query with scope into reply
+func withSubnet(r, msg *dns.Msg, scope uint8) {
+
+	// option of upstream reply is replaced
+	if opt := msg.IsEdns0(); opt != nil {
+		options := opt.Option[:0]
+		for _, o := range opt.Option {
+			if o.Option() != dns.EDNS0SUBNET {
+				options = append(options, o)
+			}
+		}
+		opt.Option = options
+	}
+
+	e := ecsOption(r)
+	if e == nil {
+		return
+	}
+
+	if msg.IsEdns0() == nil {
+		msg.SetEdns0(dns.MinMsgSize, false)
+	}
+
+	if e.Family == 1 && scope > 32 {
+		scope = 32
+	}
+
+	opt := msg.IsEdns0()
+	opt.Option = append(opt.Option, &dns.EDNS0_SUBNET{
+		Code:          dns.EDNS0SUBNET,
+		Family:        e.Family,
+		SourceNetmask: e.SourceNetmask,
+		SourceScope:   scope,
+		Address:       e.Address,
+	})
+}
+
+// upstreamSubnet query for upstream: without client subnet when mode is off, otherwise with
+// client subnet of query or of public address of client, shortened to configured prefix lengths
+func upstreamSubnet(r *dns.Msg, subnet *net.IPNet, mode string, v4, v6 int) *dns.Msg {
+
+	e := ecsOption(r)
+	if mode != ecsAdd && e == nil {
+		return r
+	}
+
+	m := r.Copy()
+	withSubnet(&dns.Msg{}, m, 0)
+
+	if mode != ecsAdd || subnet == nil {
+		return m
+	}
+
+	// private networks say nothing about location
+	if e == nil && (subnet.IP.IsPrivate() || subnet.IP.IsLoopback() || subnet.IP.IsLinkLocalUnicast()) {
+		return m
+	}
+
+	ones, bits := subnet.Mask.Size()
+	family, max := uint16(1), v4
+	if bits == 128 {
+		family, max = 2, v6
+	}
+	if ones > max {
+		ones = max
+	}
+
+	if m.IsEdns0() == nil {
+		m.SetEdns0(dns.DefaultMsgSize, false)
+	}
+	opt := m.IsEdns0()
+	opt.Option = append(opt.Option, &dns.EDNS0_SUBNET{
+		Code:          dns.EDNS0SUBNET,
+		Family:        family,
+		SourceNetmask: uint8(ones),
+		Address:       subnet.IP.Mask(net.CIDRMask(ones, bits)),
+	})
+	return m
+}
+
+// replyScope scope of client subnet in upstream reply, 0 when the answer is valid for everybody
+func replyScope(msg *dns.Msg) uint8 {
+	if e := ecsOption(msg); e != nil {
+		return e.SourceScope
+	}
+	return 0
+}
+
+// subnetRecords records of zone for network of client, longest prefix wins;
+// nil when zone has no records for client subnet
+func subnetRecords(entry *models.DNSEntry, subnet *net.IPNet) *models.SubnetRecords {
+
+	if subnet == nil {
+		return nil
+	}
+
+	var (
+		best    *models.SubnetRecords
+		longest = -1
+	)
+
+	for _, v := range entry.Subnets {
+		if v == nil {
+			continue
+		}
+		_, network, err := net.ParseCIDR(strings.TrimSpace(v.Subnet))
+		if err != nil || !network.Contains(subnet.IP) {
+			continue
+		}
+		// client subnet must lie completely in network of records
+		ones, _ := network.Mask.Size()
+		if client, _ := subnet.Mask.Size(); client < ones {
+			continue
+		}
+		if ones > longest {
+			best, longest = v, ones
+		}
+	}
+
+	return best
+}
+
+// parseClient network of address or network in CIDR notation
+func parseClient(v string) (*net.IPNet, error) {
+	if strings.Contains(v, "/") {
+		_, network, err := net.ParseCIDR(v)
+		return network, err
+	}
+	ip := net.ParseIP(v)
+	if ip == nil {
+		return nil, errors.New("invalid ip")
+	}
+	return clientSubnet(&dns.Msg{}, ip)
+}
@@ -0,0 +1,122 @@
+package dns
+
+import (
+	"net"
+	"testing"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	"github.com/miekg/dns"
+)
+
+// ecsQuery query with client subnet
+func ecsQuery(family uint16, addr string, prefix uint8) *dns.Msg {
+	r := &dns.Msg{}
+	r.SetQuestion("example.com.", dns.TypeA)
+	r.SetEdns0(1232, false)
+	opt := r.IsEdns0()
+	opt.Option = append(opt.Option, &dns.EDNS0_SUBNET{
+		Code:          dns.EDNS0SUBNET,
+		Family:        family,
+		SourceNetmask: prefix,
+		Address:       net.ParseIP(addr),
+	})
+	return r
+}
+
+func TestClientSubnet(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		r       *dns.Msg
+		want    string
+		wantErr bool
+	}{
+		{"without_ecs", &dns.Msg{}, "192.0.2.1/32", false},
+		{"ipv4", ecsQuery(1, "198.51.100.0", 24), "198.51.100.0/24", false},
+		{"ipv6", ecsQuery(2, "2001:db8::", 56), "2001:db8::/56", false},
+		{"host_bits_set", ecsQuery(1, "198.51.100.7", 24), "", true},
+		{"prefix_too_long", ecsQuery(1, "198.51.100.0", 33), "", true},
+		{"unknown_family", ecsQuery(3, "198.51.100.0", 24), "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := clientSubnet(tt.r, net.ParseIP("192.0.2.1"))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("clientSubnet() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("clientSubnet() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpstreamSubnet(t *testing.T) {
+
+	_, public, _ := net.ParseCIDR("203.0.113.77/32")
+	_, private, _ := net.ParseCIDR("10.1.2.3/32")
+
+	tests := []struct {
+		name   string
+		r      *dns.Msg
+		subnet *net.IPNet
+		mode   string
+		want   string
+	}{
+		{"off_strips", ecsQuery(1, "198.51.100.0", 24), public, ecsOff, ""},
+		{"add_public_client", &dns.Msg{}, public, ecsAdd, "203.0.113.0/24"},
+		{"add_private_client", &dns.Msg{}, private, ecsAdd, ""},
+		{"add_shortens_ecs", ecsQuery(1, "198.51.100.128", 25), &net.IPNet{IP: net.ParseIP("198.51.100.128").To4(), Mask: net.CIDRMask(25, 32)}, ecsAdd, "198.51.100.0/24"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.r.Question) == 0 {
+				tt.r.SetQuestion("example.com.", dns.TypeA)
+			}
+			m := upstreamSubnet(tt.r, tt.subnet, tt.mode, 24, 56)
+			got := ""
+			if e := ecsOption(m); e != nil {
+				got = (&net.IPNet{IP: e.Address, Mask: net.CIDRMask(int(e.SourceNetmask), 32)}).String()
+			}
+			if got != tt.want {
+				t.Errorf("upstream subnet = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSubnetRecords(t *testing.T) {
+
+	entry := &models.DNSEntry{
+		Domain: "example.com.",
+		Ipv4s:  []string{"192.0.2.1"},
+		Subnets: []*models.SubnetRecords{
+			{Subnet: "198.51.100.0/22", Ipv4s: []string{"192.0.2.10"}},
+			{Subnet: "198.51.100.0/24", Ipv4s: []string{"192.0.2.20"}},
+		},
+	}
+
+	s := &DNS{}
+	answer := func(subnet string) string {
+		_, network, _ := net.ParseCIDR(subnet)
+		msg := &dns.Msg{}
+		msg.SetQuestion("example.com.", dns.TypeA)
+		s.a(msg, entry, dns.RR_Header{Name: "example.com.", Rrtype: dns.TypeA, Class: dns.ClassINET}, network)
+		return msg.Answer[0].(*dns.A).A.String()
+	}
+
+	tests := []struct {
+		subnet string
+		want   string
+	}{
+		{"198.51.100.0/24", "192.0.2.20"},
+		{"198.51.101.0/24", "192.0.2.10"},
+		{"198.51.100.0/16", "192.0.2.1"},
+		{"203.0.113.0/24", "192.0.2.1"},
+	}
+	for _, tt := range tests {
+		if got := answer(tt.subnet); got != tt.want {
+			t.Errorf("answer for %v = %v, want %v", tt.subnet, got, tt.want)
+		}
+	}
+}
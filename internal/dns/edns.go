@@ -0,0 +1,75 @@
+package dns
+
+import (
+	"net"
+
+	"github.com/miekg/dns"
+)
+
+// badVersion reply with BADVERS on query with EDNS version other than 0, false for supported queries
+func badVersion(r, msg *dns.Msg) bool {
+	opt := r.IsEdns0()
+	if opt == nil || opt.Version() == 0 {
+		return false
+	}
+	msg.SetRcode(r, dns.RcodeBadVers)
+	return true
+}
+
+// edns replace OPT record of reply with own one and truncate reply to size of transport:
+// 512 bytes for udp without EDNS, payload size of client up to configured maximum with EDNS,
+// 64k for stream transports; cookie of client at ip is put into OPT before, so it fits into size too
+func (s *DNS) edns(r, msg *dns.Msg, udp bool, ip net.IP) {
+
+	// OPT of upstream reply is not passed to client, only client subnet of reply is kept
+	ecs := ecsOption(msg)
+	extra := msg.Extra[:0]
+	for _, rr := range msg.Extra {
+		if rr.Header().Rrtype != dns.TypeOPT {
+			extra = append(extra, rr)
+		}
+	}
+	msg.Extra = extra
+
+	size := dns.MinMsgSize
+
+	if opt := r.IsEdns0(); opt != nil {
+		max := s.Config.EdnsUdpSize
+		if max < dns.MinMsgSize {
+			max = dns.MinMsgSize
+		}
+		o := &dns.OPT{Hdr: dns.RR_Header{Name: ".", Rrtype: dns.TypeOPT}}
+		o.SetUDPSize(max)
+		o.SetDo(opt.Do())
+		if ecs != nil && ecsOption(r) != nil {
+			o.Option = append(o.Option, ecs)
+		}
+		msg.Extra = append(msg.Extra, o)
+		s.Cookies.Add(r, msg, ip)
+		size = int(opt.UDPSize())
+		if size > int(max) {
+			size = int(max)
+		}
+	}
+
+	if !udp {
+		size = dns.MaxMsgSize
+	}
+
+	// signature of reply is about as long as signature of request
+	if t := r.IsTsig(); t != nil {
+		size -= dns.Len(t)
+	}
+
+	// addresses in additional section of answers are left out before answer is truncated,
+	// glue of referrals is needed and truncates
+	if len(msg.Answer) > 0 {
+		for i := len(msg.Extra) - 1; i >= 0 && msg.Len() > size; i-- {
+			if msg.Extra[i].Header().Rrtype != dns.TypeOPT {
+				msg.Extra = append(msg.Extra[:i], msg.Extra[i+1:]...)
+			}
+		}
+	}
+
+	msg.Truncate(size)
+}
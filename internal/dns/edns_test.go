@@ -0,0 +1,151 @@
+package dns
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/MarlikAlmighty/mdns/internal/config"
+	"github.com/miekg/dns"
+)
+
+func TestDNS_edns(t *testing.T) {
+
+	s := &DNS{Config: &config.Configuration{EdnsUdpSize: 1232}}
+
+	// reply of 100 addresses is about 1.6k
+	reply := func(r *dns.Msg) *dns.Msg {
+		msg := &dns.Msg{}
+		msg.SetReply(r)
+		for i := 0; i < 100; i++ {
+			msg.Answer = append(msg.Answer, &dns.A{
+				Hdr: dns.RR_Header{Name: r.Question[0].Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60},
+				A:   net.ParseIP(fmt.Sprintf("192.0.2.%d", i)),
+			})
+		}
+		// OPT of upstream
+		msg.SetEdns0(4096, false)
+		return msg
+	}
+
+	tests := []struct {
+		name     string
+		bufsize  uint16
+		udp      bool
+		maxLen   int
+		tc       bool
+		opt      bool
+		optSize  uint16
+		complete bool
+	}{
+		{"udp_without_edns", 0, true, dns.MinMsgSize, true, false, 0, false},
+		{"udp_small_buffer", 800, true, 800, true, true, 1232, false},
+		{"udp_capped_at_max", 4096, true, 1232, true, true, 1232, false},
+		{"tcp_without_edns", 0, false, dns.MaxMsgSize, false, false, 0, true},
+		{"tcp_with_edns", 512, false, dns.MaxMsgSize, false, true, 1232, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &dns.Msg{}
+			r.SetQuestion("example.com.", dns.TypeA)
+			if tt.bufsize > 0 {
+				r.SetEdns0(tt.bufsize, true)
+			}
+			msg := reply(r)
+			s.edns(r, msg, tt.udp, nil)
+
+			b, err := msg.Pack()
+			if err != nil {
+				t.Fatalf("Pack() error = %v", err)
+			}
+			if len(b) > tt.maxLen {
+				t.Errorf("length = %v, want at most %v", len(b), tt.maxLen)
+			}
+			if msg.Truncated != tt.tc {
+				t.Errorf("TC = %v, want %v", msg.Truncated, tt.tc)
+			}
+			if (len(msg.Answer) == 100) != tt.complete {
+				t.Errorf("answers = %v", len(msg.Answer))
+			}
+			opt := msg.IsEdns0()
+			if (opt != nil) != tt.opt {
+				t.Fatalf("OPT = %v, want %v", opt, tt.opt)
+			}
+			if opt != nil && (opt.UDPSize() != tt.optSize || !opt.Do()) {
+				t.Errorf("OPT size = %v do = %v", opt.UDPSize(), opt.Do())
+			}
+		})
+	}
+}
+
+func TestBadVersion(t *testing.T) {
+
+	r := &dns.Msg{}
+	r.SetQuestion("example.com.", dns.TypeA)
+	r.SetEdns0(1232, false)
+	r.IsEdns0().SetVersion(1)
+
+	msg := &dns.Msg{}
+	msg.SetReply(r)
+	if !badVersion(r, msg) {
+		t.Fatalf("badVersion() = false, want true")
+	}
+
+	s := &DNS{Config: &config.Configuration{EdnsUdpSize: 1232}}
+	s.edns(r, msg, true, nil)
+	b, err := msg.Pack()
+	if err != nil {
+		t.Fatalf("Pack() error = %v", err)
+	}
+	m := &dns.Msg{}
+	if err = m.Unpack(b); err != nil {
+		t.Fatalf("Unpack() error = %v", err)
+	}
+	if m.Rcode != dns.RcodeBadVers || m.IsEdns0() == nil || m.IsEdns0().Version() != 0 {
+		t.Errorf("reply rcode = %v", dns.RcodeToString[m.Rcode])
+	}
+
+	r.IsEdns0().SetVersion(0)
+	if badVersion(r, msg) {
+		t.Errorf("badVersion() = true for version 0")
+	}
+}
+
+func TestDNS_ednsCookie(t *testing.T) {
+
+	cookies, err := NewCookies("", false)
+	if err != nil {
+		t.Fatalf("NewCookies() error = %v", err)
+	}
+	s := &DNS{Config: &config.Configuration{EdnsUdpSize: 1232}, Cookies: cookies}
+	client := net.ParseIP("192.0.2.1")
+
+	// answers which fill the buffer of client up to a few bytes, cookie of reply still fits
+	for n := 40; n < 60; n++ {
+		r := &dns.Msg{}
+		r.SetQuestion("example.com.", dns.TypeA)
+		r.SetEdns0(800, false)
+		r.IsEdns0().Option = append(r.IsEdns0().Option, &dns.EDNS0_COOKIE{Code: dns.EDNS0COOKIE, Cookie: "0102030405060708"})
+
+		msg := &dns.Msg{}
+		msg.SetReply(r)
+		for i := 0; i < n; i++ {
+			msg.Answer = append(msg.Answer, &dns.A{
+				Hdr: dns.RR_Header{Name: "example.com.", Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60},
+				A:   net.ParseIP(fmt.Sprintf("192.0.2.%d", i)),
+			})
+		}
+		s.edns(r, msg, true, client)
+
+		b, err := msg.Pack()
+		if err != nil {
+			t.Fatalf("Pack() error = %v", err)
+		}
+		if len(b) > 800 {
+			t.Errorf("length of %v answers = %v, want at most 800", n, len(b))
+		}
+		if !cookies.Valid(&dns.Msg{Extra: msg.Extra}, client) {
+			t.Errorf("reply of %v answers without server cookie", n)
+		}
+	}
+}
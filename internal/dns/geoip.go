@@ -0,0 +1,149 @@
+package dns
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	"github.com/oschwald/maxminddb-golang"
+)
+
+// geoReader database of locations, implemented by maxminddb.Reader
+type geoReader interface {
+	Lookup(ip net.IP, result any) error
+	Close() error
+}
+
+// geoRecord fields of GeoIP2/GeoLite2 country, city and asn databases
+type geoRecord struct {
+	Continent struct {
+		Code string `maxminddb:"code"`
+	} `maxminddb:"continent"`
+	Country struct {
+		ISOCode string `maxminddb:"iso_code"`
+	} `maxminddb:"country"`
+	ASN uint `maxminddb:"autonomous_system_number"`
+}
+
+// GeoIP locations of clients from local MaxMind databases
+type GeoIP struct {
+	files   []string
+	readers []geoReader
+	mux     sync.RWMutex
+}
+
+// NewGeoIP simple constructor, without files every client has unknown location
+func NewGeoIP(files []string) *GeoIP {
+	return &GeoIP{files: files}
+}
+
+// Load open databases, like country or city database together with asn database
+func (g *GeoIP) Load() error {
+
+	var readers []geoReader
+	for _, f := range g.files {
+		r, err := maxminddb.Open(f)
+		if err != nil {
+			for _, v := range readers {
+				_ = v.Close()
+			}
+			return fmt.Errorf("geoip: %w", err)
+		}
+		readers = append(readers, r)
+	}
+
+	g.mux.Lock()
+	old := g.readers
+	g.readers = readers
+	g.mux.Unlock()
+
+	for _, v := range old {
+		_ = v.Close()
+	}
+	return nil
+}
+
+// Lookup location of address, fields missing in all databases stay empty
+func (g *GeoIP) Lookup(ip net.IP) geoRecord {
+
+	var loc geoRecord
+	if g == nil || ip == nil {
+		return loc
+	}
+
+	g.mux.RLock()
+	defer g.mux.RUnlock()
+
+	for _, r := range g.readers {
+		var rec geoRecord
+		if err := r.Lookup(ip, &rec); err != nil {
+			continue
+		}
+		if loc.Continent.Code == "" {
+			loc.Continent.Code = rec.Continent.Code
+		}
+		if loc.Country.ISOCode == "" {
+			loc.Country.ISOCode = rec.Country.ISOCode
+		}
+		if loc.ASN == 0 {
+			loc.ASN = rec.ASN
+		}
+	}
+
+	return loc
+}
+
+// Close close databases
+func (g *GeoIP) Close() error {
+	g.mux.Lock()
+	defer g.mux.Unlock()
+	for _, v := range g.readers {
+		_ = v.Close()
+	}
+	g.readers = nil
+	return nil
+}
+
+// geoRecords records of zone for location, asn before country before continent;
+// nil when zone has no records for location
+func geoRecords(entry *models.DNSEntry, loc geoRecord) *models.GeoRecords {
+
+	var country, continent *models.GeoRecords
+
+	for _, v := range entry.Geo {
+		if v == nil {
+			continue
+		}
+		for _, asn := range v.Asns {
+			if loc.ASN != 0 && asn == int64(loc.ASN) {
+				return v
+			}
+		}
+		if country == nil && contains(v.Countries, loc.Country.ISOCode) {
+			country = v
+		}
+		if continent == nil && contains(v.Continents, loc.Continent.Code) {
+			continent = v
+		}
+	}
+
+	if country != nil {
+		return country
+	}
+	return continent
+}
+
+// contains list has code, case is ignored
+func contains(list []string, code string) bool {
+	if code == "" {
+		return false
+	}
+	for _, v := range list {
+		if strings.EqualFold(strings.TrimSpace(v), code) {
+			return true
+		}
+	}
+	return false
+}
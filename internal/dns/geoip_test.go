@@ -0,0 +1,107 @@
+package dns
+
+import (
+	"net"
+	"testing"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	"github.com/miekg/dns"
+)
+
+// fakeReader database of locations by network
+type fakeReader map[string]geoRecord
+
+func (f fakeReader) Lookup(ip net.IP, result any) error {
+	for k, v := range f {
+		if _, network, _ := net.ParseCIDR(k); network.Contains(ip) {
+			*result.(*geoRecord) = v
+		}
+	}
+	return nil
+}
+
+func (f fakeReader) Close() error {
+	return nil
+}
+
+func geo(continent, country string, asn uint) geoRecord {
+	var r geoRecord
+	r.Continent.Code, r.Country.ISOCode, r.ASN = continent, country, asn
+	return r
+}
+
+func TestGeoIP_Lookup(t *testing.T) {
+
+	g := NewGeoIP(nil)
+	g.readers = []geoReader{
+		fakeReader{"192.0.2.0/24": geo("EU", "DE", 0)},
+		fakeReader{"192.0.2.0/25": geo("", "", 64500)},
+	}
+
+	if got := g.Lookup(net.ParseIP("192.0.2.1")); got != geo("EU", "DE", 64500) {
+		t.Errorf("Lookup() = %+v", got)
+	}
+	if got := g.Lookup(net.ParseIP("198.51.100.1")); got != (geoRecord{}) {
+		t.Errorf("Lookup() unknown = %+v", got)
+	}
+	if err := NewGeoIP([]string{"/nonexistent.mmdb"}).Load(); err == nil {
+		t.Errorf("Load() of missing file got no error")
+	}
+}
+
+func TestDNS_addresses(t *testing.T) {
+
+	g := NewGeoIP(nil)
+	g.readers = []geoReader{fakeReader{
+		"192.0.2.0/26":    geo("EU", "DE", 64500),
+		"192.0.2.64/26":   geo("EU", "DE", 0),
+		"192.0.2.128/26":  geo("EU", "FR", 0),
+		"198.51.100.0/24": geo("NA", "US", 0),
+	}}
+	s := &DNS{GeoIP: g}
+
+	entry := &models.DNSEntry{
+		Domain: "example.com.",
+		Ipv4s:  []string{"203.0.113.1"},
+		Ipv6s:  []string{"2001:db8::1"},
+		Geo: []*models.GeoRecords{
+			{Continents: []string{"eu"}, Ipv4s: []string{"203.0.113.10"}},
+			{Countries: []string{"DE"}, Ipv4s: []string{"203.0.113.20"}, Ipv6s: []string{"2001:db8::20"}},
+			{Asns: []int64{64500}, Ipv4s: []string{"203.0.113.30"}},
+		},
+		Subnets: []*models.SubnetRecords{
+			{Subnet: "192.0.2.192/26", Ipv4s: []string{"203.0.113.40"}},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		client string
+		ipv4   string
+		ipv6   string
+	}{
+		{"asn", "192.0.2.1", "203.0.113.30", "2001:db8::1"},
+		{"country", "192.0.2.65", "203.0.113.20", "2001:db8::20"},
+		{"continent", "192.0.2.129", "203.0.113.10", "2001:db8::1"},
+		{"subnet", "192.0.2.193", "203.0.113.40", "2001:db8::1"},
+		{"default", "198.51.100.1", "203.0.113.1", "2001:db8::1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subnet, _ := parseClient(tt.client)
+			header := dns.RR_Header{Name: "example.com.", Class: dns.ClassINET}
+
+			msg := &dns.Msg{}
+			s.a(msg, entry, header, subnet)
+			if got := msg.Answer[0].(*dns.A).A.String(); got != tt.ipv4 {
+				t.Errorf("a() = %v, want %v", got, tt.ipv4)
+			}
+
+			msg = &dns.Msg{}
+			s.aaaa(msg, entry, header, subnet)
+			if got := msg.Answer[0].(*dns.AAAA).AAAA.String(); got != tt.ipv6 {
+				t.Errorf("aaaa() = %v, want %v", got, tt.ipv6)
+			}
+		})
+	}
+}
@@ -2,7 +2,6 @@ package dns
 
 import (
 	"fmt"
-	"log"
 	"net"
 	"strings"
 	"time"
@@ -11,10 +10,48 @@ import (
 	"github.com/miekg/dns"
 )
 
-func (s *DNS) a(msg *dns.Msg, entry *models.DNSEntry, header dns.RR_Header) {
+// addresses of zone for network of client: records of subnet before records of location before default ones,
+// without addresses which are down
+func (s *DNS) addresses(entry *models.DNSEntry, subnet *net.IPNet) ([]string, []string) {
 
-	if len(entry.Ipv4s) > 0 {
-		for _, ipv4 := range entry.Ipv4s {
+	ipv4s, ipv6s := entry.Ipv4s, entry.Ipv6s
+
+	if len(entry.Geo) > 0 && subnet != nil {
+		if rec := geoRecords(entry, s.GeoIP.Lookup(subnet.IP)); rec != nil {
+			if len(rec.Ipv4s) > 0 {
+				ipv4s = rec.Ipv4s
+			}
+			if len(rec.Ipv6s) > 0 {
+				ipv6s = rec.Ipv6s
+			}
+		}
+	}
+
+	if rec := subnetRecords(entry, subnet); rec != nil {
+		if len(rec.Ipv4s) > 0 {
+			ipv4s = rec.Ipv4s
+		}
+		if len(rec.Ipv6s) > 0 {
+			ipv6s = rec.Ipv6s
+		}
+	}
+
+	// only healthy targets are answered, synthesized AAAA records follow the healthy ipv4 addresses
+	ipv4s, ipv6s = s.Health.Filter(entry, ipv4s), s.Health.Filter(entry, ipv6s)
+	if len(ipv6s) == 0 {
+		ipv6s = synthesize(entry.IPV6Synthesis, entry.Nat64Prefix, ipv4s)
+	}
+
+	return ipv4s, ipv6s
+}
+
+func (s *DNS) a(msg *dns.Msg, entry *models.DNSEntry, header dns.RR_Header, subnet *net.IPNet) {
+
+	ipv4s, _ := s.addresses(entry, subnet)
+	ipv4s = s.Balancer.Select(entry, "ipv4", ipv4s)
+
+	if len(ipv4s) > 0 {
+		for _, ipv4 := range ipv4s {
 			msg.Answer = append(msg.Answer,
 				&dns.A{
 					Hdr: header,
@@ -24,10 +61,13 @@ func (s *DNS) a(msg *dns.Msg, entry *models.DNSEntry, header dns.RR_Header) {
 	}
 }
 
-func (s *DNS) aaaa(msg *dns.Msg, entry *models.DNSEntry, header dns.RR_Header) {
+func (s *DNS) aaaa(msg *dns.Msg, entry *models.DNSEntry, header dns.RR_Header, subnet *net.IPNet) {
 
-	if len(entry.Ipv6s) > 0 {
-		for _, ipv6 := range entry.Ipv6s {
+	_, ipv6s := s.addresses(entry, subnet)
+	ipv6s = s.Balancer.Select(entry, "ipv6", ipv6s)
+
+	if len(ipv6s) > 0 {
+		for _, ipv6 := range ipv6s {
 			msg.Answer = append(msg.Answer,
 				&dns.AAAA{
 					Hdr:  header,
@@ -47,86 +87,116 @@ func (s *DNS) caa(msg *dns.Msg, header dns.RR_Header) {
 		})
 }
 
-func (s *DNS) txt(msg *dns.Msg, entry *models.DNSEntry) {
+// DkimSelector selector of first DKIM key of zone, generated when the zone is added
+const DkimSelector = "mail"
 
-	outDot := strings.TrimSuffix(msg.Question[0].Name, ".")
-	outDot = strings.ToLower(outDot)
-	serviceSegments := dns.SplitDomainName(outDot)
-	outDotEntry := strings.TrimSuffix(entry.Domain, ".")
+// chunks split string of TXT record into character strings of at most 255 bytes (RFC 1035 section 3.3)
+func chunks(v string) []string {
+	if v == "" {
+		return []string{""}
+	}
+	var out []string
+	for len(v) > 255 {
+		out = append(out, v[:255])
+		v = v[255:]
+	}
+	return append(out, v)
+}
 
-	switch serviceSegments[0] {
+// txt answer TXT records of exactly the name of query: stored records, published DKIM keys at
+// <selector>._domainkey, records of mail policy like SPF, DMARC and MTA-STS, acme challenge;
+// nodata for other names
+func (s *DNS) txt(msg *dns.Msg, entry *models.DNSEntry) {
 
-	case "mail":
+	name := strings.ToLower(msg.Question[0].Name)
+	zone := strings.ToLower(entry.Domain)
+	header := dns.RR_Header{
+		Name:   msg.Question[0].Name,
+		Rrtype: dns.TypeTXT,
+		Class:  dns.ClassINET,
+		Ttl:    60,
+	}
 
-		cert := fmt.Sprintf("v=DKIM1; k=rsa; p=%s", entry.DkimPublicKey)
-		if len(cert) > dns.MinMsgSize {
-			log.Printf("[ERR]: cert is %v over then dns.MinMsgSize \n", len(cert))
-			return
+	var values [][]string
+	var stored []string
+	for _, v := range entry.Txts {
+		if v == nil || owner(entry.Domain, v.Name) != name {
+			continue
 		}
+		stored = append(stored, strings.Join(v.Values, ""))
+		values = append(values, v.Values)
+	}
 
-		if len(cert) == 0 {
-			log.Printf("[ERR]: cert is %v zero length \n", len(cert))
-			return
+	// a stored record of same version replaces the generated one
+	generate := func(version string) bool {
+		for _, v := range stored {
+			if strings.HasPrefix(v, version) {
+				return false
+			}
 		}
+		return true
+	}
+	mail := entry.Mail
+	if mail == nil {
+		mail = &models.MailPolicy{}
+	}
 
-		var dkim []string
-		dkim = append(dkim, cert[:254])
-		dkim = append(dkim, cert[254:])
-
-		msg.Answer = append(msg.Answer,
-			&dns.TXT{
-				Hdr: dns.RR_Header{
-					Name:   "mail._domainkey." + entry.Domain,
-					Rrtype: dns.TypeTXT,
-					Class:  dns.ClassINET,
-					Ttl:    60,
-				},
-				Txt: dkim,
-			})
-
-	case "_dmarc":
-		msg.Answer = append(msg.Answer,
-			&dns.TXT{
-				Hdr: dns.RR_Header{
-					Name:   "_dmarc." + entry.Domain,
-					Rrtype: dns.TypeTXT,
-					Class:  dns.ClassINET,
-					Ttl:    60,
-				},
-				Txt: []string{"v=DMARC1; p=none; sp=none; rua=mailto:admin@" + outDotEntry},
-			})
-
-	case "_acme-challenge":
-		msg.Answer = append(msg.Answer,
-			&dns.TXT{
-				Hdr: dns.RR_Header{
-					Name:   "_acme-challenge." + entry.Domain,
-					Rrtype: dns.TypeTXT,
-					Class:  dns.ClassINET,
-					Ttl:    60,
-				},
-				Txt: entry.Acme,
-			})
-
-	default:
+	switch {
+	case strings.HasSuffix(name, "._domainkey."+zone):
+		selector := strings.TrimSuffix(name, "._domainkey."+zone)
+		for _, key := range s.DKIM.published(entry, selector) {
+			values = append(values, []string{dkimRecord(key)})
+		}
+		// zones without key objects have their key at the generated selector
+		if len(entry.DkimKeys) == 0 && selector == DkimSelector && entry.DkimPublicKey != "" {
+			values = append(values, []string{fmt.Sprintf("v=DKIM1; k=rsa; p=%s", entry.DkimPublicKey)})
+		}
+	case name == "_dmarc."+zone:
+		if generate("v=DMARC1") {
+			if v, err := ParseDMARC(entry, mail.Dmarc); err == nil {
+				values = append(values, []string{v})
+			}
+		}
+	case name == "_mta-sts."+zone:
+		if mail.MtaSts != nil && generate("v=STSv1") {
+			if _, id, err := ParseMTASTS(entry, mail.MtaSts); err == nil {
+				values = append(values, []string{"v=STSv1; id=" + id})
+			}
+		}
+	case name == "_smtp._tls."+zone:
+		if mail.TLSRpt != nil && generate("v=TLSRPTv1") {
+			if v, err := ParseTLSRPT(mail.TLSRpt); err == nil {
+				values = append(values, []string{v})
+			}
+		}
+	case name == "default._bimi."+zone:
+		if mail.Bimi != nil && generate("v=BIMI1") {
+			if v, err := ParseBIMI(mail.Bimi); err == nil {
+				values = append(values, []string{v})
+			}
+		}
+	case name == "_acme-challenge."+zone:
+		if len(entry.Acme) > 0 {
+			values = append(values, entry.Acme)
+		}
+	case name == zone:
+		if generate("v=spf1") {
+			if v, err := ParseSPF(entry, mail.Spf); err == nil {
+				values = append(values, []string{v})
+			}
+		}
+	}
 
-		var (
-			ipv4 string
-			spf  []string
-		)
+	for _, v := range values {
+		var txt []string
+		for _, str := range v {
+			txt = append(txt, chunks(str)...)
+		}
+		msg.Answer = append(msg.Answer, &dns.TXT{Hdr: header, Txt: txt})
+	}
 
-		ipv4 = strings.Join(entry.Ipv4s, " ip4:")
-		spf = append(spf, fmt.Sprintf("v=spf1 ip4:%v include:_spf.%v a mx all", ipv4, outDot))
-		msg.Answer = append(msg.Answer,
-			&dns.TXT{
-				Hdr: dns.RR_Header{
-					Name:   entry.Domain,
-					Rrtype: dns.TypeTXT,
-					Class:  dns.ClassINET,
-					Ttl:    60,
-				},
-				Txt: spf,
-			})
+	if len(msg.Answer) == 0 {
+		s.nodata(msg, entry)
 	}
 }
 
@@ -139,7 +209,7 @@ func (s *DNS) soa(msg *dns.Msg, entry *models.DNSEntry) {
 				Class:  dns.ClassINET,
 				Ttl:    3600,
 			},
-			Ns:      "ns1." + entry.Domain,
+			Ns:      owner(entry.Domain, nameServers(entry)[0].Name),
 			Mbox:    "admin." + entry.Domain,
 			Serial:  uint32(time.Now().Unix()),
 			Refresh: 900,
@@ -149,41 +219,27 @@ func (s *DNS) soa(msg *dns.Msg, entry *models.DNSEntry) {
 		})
 }
 
+// ns NS records of zone
 func (s *DNS) ns(msg *dns.Msg, entry *models.DNSEntry) {
-	msg.Answer = append(msg.Answer,
-		&dns.NS{
-			Hdr: dns.RR_Header{
-				Name:   entry.Domain,
-				Rrtype: dns.TypeNS,
-				Class:  dns.ClassINET,
-				Ttl:    600,
-			},
-			Ns: "ns1." + entry.Domain,
-		},
-		&dns.NS{
-			Hdr: dns.RR_Header{
-				Name:   entry.Domain,
-				Rrtype: dns.TypeNS,
-				Class:  dns.ClassINET,
-				Ttl:    600,
-			},
-			Ns: "ns2." + entry.Domain,
-		})
+	for _, v := range nameServers(entry) {
+		msg.Answer = append(msg.Answer,
+			&dns.NS{
+				Hdr: dns.RR_Header{
+					Name:   entry.Domain,
+					Rrtype: dns.TypeNS,
+					Class:  dns.ClassINET,
+					Ttl:    600,
+				},
+				Ns: owner(entry.Domain, v.Name),
+			})
+	}
 }
 
-func (s *DNS) ptr(msg *dns.Msg, entry *models.DNSEntry) {
-	var reverseIpAddress string
-	reverseIpAddress = s.reverseIP(net.ParseIP(entry.Ipv4s[0])) + ".in-addr.arpa."
-	msg.Answer = append(msg.Answer,
-		&dns.PTR{
-			Hdr: dns.RR_Header{
-				Name:   reverseIpAddress,
-				Rrtype: dns.TypePTR,
-				Class:  dns.ClassINET,
-				Ttl:    600,
-			},
-			Ptr: entry.Domain,
-		})
+// nodata answer without records, SOA of zone in authority section for negative caching
+func (s *DNS) nodata(msg *dns.Msg, entry *models.DNSEntry) {
+	m := &dns.Msg{}
+	s.soa(m, entry)
+	msg.Ns = append(msg.Ns, m.Answer...)
 }
 
 func (s *DNS) mx(msg *dns.Msg, entry *models.DNSEntry) {
@@ -0,0 +1,334 @@
+package dns
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/miekg/dns"
+)
+
+// types of health checks, and answer without addresses when every address is down
+const (
+	checkTCP    = "tcp"
+	checkPing   = "ping"
+	checkHTTP   = "http"
+	checkHTTPS  = "https"
+	checkDNS    = "dns"
+	allDownNone = "none"
+)
+
+// defaults of health checks
+const (
+	healthInterval = 10 * time.Second
+	healthTimeout  = 2 * time.Second
+	healthRise     = 2
+	healthFall     = 3
+)
+
+// healthTarget one address of dns entry with its check and status
+type healthTarget struct {
+	domain  string
+	check   models.HealthCheck
+	status  models.HealthStatus
+	next    time.Time
+	running bool
+}
+
+// Health scheduler of health checks for addresses of dns entries
+type Health struct {
+	targets map[string]*healthTarget
+	now     func() time.Time
+	probe   func(ctx context.Context, domain, address string, check *models.HealthCheck) error
+	mux     sync.Mutex
+}
+
+// NewHealth simple constructor
+func NewHealth() *Health {
+	return &Health{
+		targets: make(map[string]*healthTarget),
+		now:     time.Now,
+		probe:   probe,
+	}
+}
+
+// Run check due addresses of entries every interval until context is done
+func (h *Health) Run(ctx context.Context, interval time.Duration, entries func() []models.DNSEntry) {
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, t := range h.schedule(entries()) {
+				go h.run(ctx, t)
+			}
+		}
+	}
+}
+
+// schedule update targets from entries, returns targets which are due for check
+func (h *Health) schedule(entries []models.DNSEntry) []*healthTarget {
+
+	h.mux.Lock()
+
+	now := h.now()
+	seen := make(map[string]bool)
+	var due []*healthTarget
+
+	for _, entry := range entries {
+		if entry.HealthCheck == nil {
+			continue
+		}
+		for _, address := range targets(&entry) {
+			key := healthKey(entry.Domain, address)
+			seen[key] = true
+			t, ok := h.targets[key]
+			if !ok {
+				// unchecked addresses are healthy
+				t = &healthTarget{
+					domain: DomainKey(entry.Domain),
+					status: models.HealthStatus{Address: address, Healthy: true},
+					next:   now,
+				}
+				h.targets[key] = t
+			}
+			t.check = *entry.HealthCheck
+			if !t.running && !now.Before(t.next) {
+				t.running = true
+				t.next = now.Add(seconds(t.check.Interval, healthInterval))
+				due = append(due, t)
+			}
+		}
+	}
+
+	// addresses which were removed from entries
+	for k := range h.targets {
+		if !seen[k] {
+			delete(h.targets, k)
+		}
+	}
+
+	h.mux.Unlock()
+	return due
+}
+
+// run check of one target and update its status
+func (h *Health) run(ctx context.Context, t *healthTarget) {
+
+	h.mux.Lock()
+	check, address := t.check, t.status.Address
+	h.mux.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, seconds(check.Timeout, healthTimeout))
+	err := h.probe(ctx, t.domain, address, &check)
+	cancel()
+
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	t.running = false
+	t.status.Checked = strfmt.DateTime(h.now())
+	if err == nil {
+		t.status.Successes++
+		t.status.Failures = 0
+		t.status.Error = ""
+		if !t.status.Healthy && t.status.Successes >= count(check.Rise, healthRise) {
+			t.status.Healthy = true
+		}
+		return
+	}
+	t.status.Failures++
+	t.status.Successes = 0
+	t.status.Error = err.Error()
+	if t.status.Healthy && t.status.Failures >= count(check.Fall, healthFall) {
+		t.status.Healthy = false
+	}
+}
+
+// Filter healthy addresses of entry; when every address is down all of them
+// or none are answered as configured
+func (h *Health) Filter(entry *models.DNSEntry, addrs []string) []string {
+
+	if h == nil || entry.HealthCheck == nil || len(addrs) == 0 {
+		return addrs
+	}
+
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	var up []string
+	for _, a := range addrs {
+		if t, ok := h.targets[healthKey(entry.Domain, a)]; !ok || t.status.Healthy {
+			up = append(up, a)
+		}
+	}
+
+	if len(up) == 0 && entry.HealthCheck.AllDown != allDownNone {
+		return addrs
+	}
+	return up
+}
+
+// Get status of checks of entry by domain
+func (h *Health) Get(domain string) models.HealthStatusList {
+	return h.GetMap()[DomainKey(domain)]
+}
+
+// GetMap status of checks of all entries by domain
+func (h *Health) GetMap() models.HealthStatuses {
+
+	h.mux.Lock()
+	mp := make(models.HealthStatuses)
+	for _, t := range h.targets {
+		status := t.status
+		mp[t.domain] = append(mp[t.domain], &status)
+	}
+	h.mux.Unlock()
+
+	for _, v := range mp {
+		sort.Slice(v, func(i, j int) bool { return v[i].Address < v[j].Address })
+	}
+	return mp
+}
+
+// probe check address once
+func probe(ctx context.Context, domain, address string, check *models.HealthCheck) error {
+
+	port := int(check.Port)
+	switch {
+	case port != 0:
+	case check.Type == checkHTTP:
+		port = 80
+	case check.Type == checkHTTPS:
+		port = 443
+	case check.Type == checkDNS:
+		port = 53
+	default:
+		return errors.New("health: port required")
+	}
+	addr := net.JoinHostPort(address, strconv.Itoa(port))
+	host := check.Host
+	if host == "" {
+		host = strings.TrimSuffix(domain, ".")
+	}
+
+	var d net.Dialer
+
+	switch check.Type {
+	case checkTCP, checkPing:
+		conn, err := d.DialContext(ctx, "tcp", addr)
+		if err != nil {
+			// refused connection is answered by living host
+			if check.Type == checkPing && errors.Is(err, syscall.ECONNREFUSED) {
+				return nil
+			}
+			return err
+		}
+		return conn.Close()
+
+	case checkHTTP, checkHTTPS:
+		client := &http.Client{
+			Transport: &http.Transport{
+				DialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+					return d.DialContext(ctx, network, addr)
+				},
+				TLSClientConfig:   &tls.Config{ServerName: host},
+				DisableKeepAlives: true,
+			},
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+			check.Type+"://"+host+"/"+strings.TrimPrefix(check.Path, "/"), nil)
+		if err != nil {
+			return err
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		_ = resp.Body.Close()
+		want := int(check.ExpectStatus)
+		if want == 0 {
+			want = http.StatusOK
+		}
+		if resp.StatusCode != want {
+			return fmt.Errorf("health: status %d, want %d", resp.StatusCode, want)
+		}
+		return nil
+
+	case checkDNS:
+		name, qtype := check.QueryName, dns.TypeA
+		if name == "" {
+			name = domain
+		}
+		if check.QueryType != "" {
+			qtype = dns.StringToType[strings.ToUpper(check.QueryType)]
+		}
+		m := &dns.Msg{}
+		m.SetQuestion(dns.Fqdn(name), qtype)
+		r, _, err := (&dns.Client{Net: "udp"}).ExchangeContext(ctx, m, addr)
+		if err != nil {
+			return err
+		}
+		if r.Rcode != dns.RcodeSuccess {
+			return fmt.Errorf("health: rcode %v", dns.RcodeToString[r.Rcode])
+		}
+		return nil
+	}
+
+	return fmt.Errorf("health: unknown check %q", check.Type)
+}
+
+// targets all addresses of entry, default ones and those of subnets and locations
+func targets(entry *models.DNSEntry) []string {
+	addrs := append(append([]string{}, entry.Ipv4s...), entry.Ipv6s...)
+	for _, v := range entry.Subnets {
+		if v != nil {
+			addrs = append(append(addrs, v.Ipv4s...), v.Ipv6s...)
+		}
+	}
+	for _, v := range entry.Geo {
+		if v != nil {
+			addrs = append(append(addrs, v.Ipv4s...), v.Ipv6s...)
+		}
+	}
+	return addrs
+}
+
+// healthKey key of address of entry, domain is keyed like zones
+func healthKey(domain, address string) string {
+	return DomainKey(domain) + "|" + address
+}
+
+// seconds duration of setting or default
+func seconds(v int64, def time.Duration) time.Duration {
+	if v <= 0 {
+		return def
+	}
+	return time.Duration(v) * time.Second
+}
+
+// count threshold of setting or default
+func count(v, def int64) int64 {
+	if v <= 0 {
+		return def
+	}
+	return v
+}
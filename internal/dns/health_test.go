@@ -0,0 +1,136 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+func TestHealth(t *testing.T) {
+
+	h := NewHealth()
+	now := time.Unix(1700000000, 0)
+	h.now = func() time.Time { return now }
+
+	// 192.0.2.2 is down, status is kept under normalised domain
+	h.probe = func(_ context.Context, _, address string, _ *models.HealthCheck) error {
+		if address == "192.0.2.2" {
+			return errors.New("connection refused")
+		}
+		return nil
+	}
+
+	entry := models.DNSEntry{
+		Domain:      "Example.COM",
+		Ipv4s:       []string{"192.0.2.1", "192.0.2.2"},
+		HealthCheck: &models.HealthCheck{Type: checkTCP, Port: 80, Interval: 5, Fall: 2, Rise: 2},
+	}
+
+	round := func() int {
+		due := h.schedule([]models.DNSEntry{entry})
+		for _, v := range due {
+			h.run(context.Background(), v)
+		}
+		return len(due)
+	}
+
+	// unchecked and once failed addresses are still healthy
+	if got := h.Filter(&entry, entry.Ipv4s); len(got) != 2 {
+		t.Errorf("Filter() unchecked = %v", got)
+	}
+	round()
+	if got := h.Filter(&entry, entry.Ipv4s); len(got) != 2 {
+		t.Errorf("Filter() after one failure = %v", got)
+	}
+
+	// checks wait for interval
+	if n := round(); n != 0 {
+		t.Errorf("checks before interval = %v", n)
+	}
+	now = now.Add(5 * time.Second)
+	round()
+	if got := h.Filter(&entry, entry.Ipv4s); len(got) != 1 || got[0] != "192.0.2.1" {
+		t.Errorf("Filter() after fall = %v", got)
+	}
+
+	status := h.Get("example.com")
+	if len(status) != 2 || status[1].Healthy || status[1].Failures != 2 || status[1].Error == "" {
+		t.Errorf("Get() = %+v", status[1])
+	}
+
+	// every address down
+	down := []string{"192.0.2.2"}
+	if got := h.Filter(&entry, down); len(got) != 1 {
+		t.Errorf("Filter() all down = %v", got)
+	}
+	entry.HealthCheck.AllDown = allDownNone
+	if got := h.Filter(&entry, down); len(got) != 0 {
+		t.Errorf("Filter() all down with none = %v", got)
+	}
+
+	// address comes back after rise
+	h.probe = func(context.Context, string, string, *models.HealthCheck) error { return nil }
+	for i := 0; i < 2; i++ {
+		now = now.Add(5 * time.Second)
+		round()
+	}
+	if got := h.Filter(&entry, entry.Ipv4s); len(got) != 2 {
+		t.Errorf("Filter() after rise = %v", got)
+	}
+
+	// removed addresses are forgotten
+	entry.Ipv4s = entry.Ipv4s[:1]
+	round()
+	if status = h.Get("example.com."); len(status) != 1 {
+		t.Errorf("Get() after removal = %v", len(status))
+	}
+}
+
+func TestProbe(t *testing.T) {
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/health" || r.Host != "example.com" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	u, _ := url.Parse(srv.URL)
+	port, _ := strconv.Atoi(u.Port())
+
+	// port without listener
+	l, _ := net.Listen("tcp", "127.0.0.1:0")
+	closed := l.Addr().(*net.TCPAddr).Port
+	_ = l.Close()
+
+	tests := []struct {
+		name    string
+		check   models.HealthCheck
+		wantErr bool
+	}{
+		{"tcp", models.HealthCheck{Type: checkTCP, Port: int64(port)}, false},
+		{"tcp_closed", models.HealthCheck{Type: checkTCP, Port: int64(closed)}, true},
+		{"ping_refused", models.HealthCheck{Type: checkPing, Port: int64(closed)}, false},
+		{"http", models.HealthCheck{Type: checkHTTP, Port: int64(port), Path: "/health"}, false},
+		{"http_status", models.HealthCheck{Type: checkHTTP, Port: int64(port), Path: "/other"}, true},
+		{"http_expected_status", models.HealthCheck{Type: checkHTTP, Port: int64(port), Path: "/other", ExpectStatus: 404}, false},
+		{"tcp_without_port", models.HealthCheck{Type: checkTCP}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+			err := probe(ctx, "example.com.", "127.0.0.1", &tt.check)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("probe() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,262 @@
+package dns
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	"github.com/miekg/dns"
+)
+
+var (
+	errOverrideName    = errors.New("override: empty name")
+	errOverrideUnknown = errors.New("override: name does not exist")
+)
+
+// Hosts static overrides of exact names from hosts file and rest api
+type Hosts struct {
+	File  string
+	file  map[string]models.Override
+	table map[string]models.Override
+	mtime time.Time
+	size  int64
+	mux   sync.RWMutex
+}
+
+// NewHosts simple constructor, file can be empty
+func NewHosts(file string) *Hosts {
+	return &Hosts{
+		File:  file,
+		file:  make(map[string]models.Override),
+		table: make(map[string]models.Override),
+	}
+}
+
+// Set validate and save override of rest api
+func (h *Hosts) Set(md *models.Override) error {
+
+	if md.Name == "" {
+		return errOverrideName
+	}
+
+	name := strings.ToLower(dns.Fqdn(md.Name))
+	o := models.Override{Name: name}
+
+	for _, v := range md.Ipv4s {
+		ip := net.ParseIP(v)
+		if ip == nil || ip.To4() == nil {
+			return fmt.Errorf("override: invalid ipv4 %q", v)
+		}
+		o.Ipv4s = append(o.Ipv4s, ip.String())
+	}
+
+	for _, v := range md.Ipv6s {
+		ip := net.ParseIP(v)
+		if ip == nil || ip.To4() != nil {
+			return fmt.Errorf("override: invalid ipv6 %q", v)
+		}
+		o.Ipv6s = append(o.Ipv6s, ip.String())
+	}
+
+	h.mux.Lock()
+	h.table[name] = o
+	h.mux.Unlock()
+	return nil
+}
+
+// Get fetch override by name, overrides of rest api win over hosts file
+func (h *Hosts) Get(name string) *models.Override {
+	name = strings.ToLower(dns.Fqdn(name))
+	h.mux.RLock()
+	defer h.mux.RUnlock()
+	if md, ok := h.table[name]; ok {
+		return &md
+	}
+	md := h.file[name]
+	return &md
+}
+
+// Delete override of rest api by name
+func (h *Hosts) Delete(name string) error {
+	name = strings.ToLower(dns.Fqdn(name))
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	if _, ok := h.table[name]; !ok {
+		return errOverrideUnknown
+	}
+	delete(h.table, name)
+	return nil
+}
+
+// GetMap get all overrides, from hosts file and rest api
+func (h *Hosts) GetMap() map[string]models.Override {
+	h.mux.RLock()
+	mp := make(map[string]models.Override, len(h.file)+len(h.table))
+	for k, v := range h.file {
+		mp[k] = v
+	}
+	for k, v := range h.table {
+		mp[k] = v
+	}
+	h.mux.RUnlock()
+	return mp
+}
+
+// Watch reload hosts file when it changes until context is done
+func (h *Hosts) Watch(ctx context.Context, interval time.Duration) {
+
+	if h.File == "" || interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := h.Load(); err != nil {
+				log.Printf("[ERR]: reload hosts file: %v\n", err)
+			}
+		}
+	}
+}
+
+// Load read hosts file if it was changed since last load
+func (h *Hosts) Load() error {
+
+	if h.File == "" {
+		return nil
+	}
+
+	fi, err := os.Stat(h.File)
+	if errors.Is(err, os.ErrNotExist) {
+		// removed file removes its overrides
+		h.mux.Lock()
+		h.file = make(map[string]models.Override)
+		h.mtime, h.size = time.Time{}, 0
+		h.mux.Unlock()
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	h.mux.RLock()
+	changed := !fi.ModTime().Equal(h.mtime) || fi.Size() != h.size
+	h.mux.RUnlock()
+	if !changed {
+		return nil
+	}
+
+	f, err := os.Open(h.File)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Printf("[ERR]: close hosts file: %v\n", err)
+		}
+	}()
+
+	mp, err := parseHosts(f)
+	if err != nil {
+		return err
+	}
+
+	h.mux.Lock()
+	h.file, h.mtime, h.size = mp, fi.ModTime(), fi.Size()
+	h.mux.Unlock()
+
+	log.Printf("Loaded %v names from hosts file %v \n", len(mp), h.File)
+	return nil
+}
+
+// Lookup answer on query for overridden name, false if name is not overridden
+// or the query is not about addresses
+func (h *Hosts) Lookup(r *dns.Msg) (*dns.Msg, bool) {
+
+	q := r.Question[0]
+	if q.Qtype != dns.TypeA && q.Qtype != dns.TypeAAAA {
+		return nil, false
+	}
+
+	md := h.Get(q.Name)
+	if md.Name == "" {
+		return nil, false
+	}
+
+	msg := &dns.Msg{}
+	msg.SetReply(r)
+	msg.RecursionAvailable = true
+
+	header := dns.RR_Header{
+		Name:   q.Name,
+		Rrtype: q.Qtype,
+		Class:  dns.ClassINET,
+		Ttl:    60,
+	}
+
+	// the other family stays empty, so clients use pinned addresses only
+	if q.Qtype == dns.TypeA {
+		for _, v := range md.Ipv4s {
+			msg.Answer = append(msg.Answer, &dns.A{Hdr: header, A: net.ParseIP(v)})
+		}
+	} else {
+		for _, v := range md.Ipv6s {
+			msg.Answer = append(msg.Answer, &dns.AAAA{Hdr: header, AAAA: net.ParseIP(v)})
+		}
+	}
+
+	return msg, true
+}
+
+// parseHosts read lines like "192.0.2.1 api.vendor.com api # comment"
+func parseHosts(r io.Reader) (map[string]models.Override, error) {
+
+	mp := make(map[string]models.Override)
+
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+
+		line := sc.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+
+		ip := net.ParseIP(fields[0])
+		if ip == nil {
+			continue
+		}
+
+		for _, v := range fields[1:] {
+			name := strings.ToLower(dns.Fqdn(v))
+			md := mp[name]
+			md.Name = name
+			if ip.To4() != nil {
+				md.Ipv4s = append(md.Ipv4s, ip.String())
+			} else {
+				md.Ipv6s = append(md.Ipv6s, ip.String())
+			}
+			mp[name] = md
+		}
+	}
+
+	return mp, sc.Err()
+}
@@ -0,0 +1,94 @@
+package dns
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	"github.com/miekg/dns"
+)
+
+func TestHosts_Lookup(t *testing.T) {
+
+	file := filepath.Join(t.TempDir(), "hosts")
+	if err := os.WriteFile(file, []byte("192.0.2.10 api.vendor.com # staging\n2001:db8::10 api.vendor.com\n"), 0o600); err != nil {
+		t.Fatalf("write hosts: %v", err)
+	}
+
+	h := NewHosts(file)
+	if err := h.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if err := h.Set(&models.Override{Name: "cdn.vendor.com", Ipv4s: []string{"192.0.2.20"}}); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		qname   string
+		qtype   uint16
+		found   bool
+		answers int
+	}{
+		{"file_a", "api.vendor.com.", dns.TypeA, true, 1},
+		{"file_aaaa", "API.vendor.com.", dns.TypeAAAA, true, 1},
+		{"table_a", "cdn.vendor.com.", dns.TypeA, true, 1},
+		{"table_aaaa_nodata", "cdn.vendor.com.", dns.TypeAAAA, true, 0},
+		{"subdomain_not_overridden", "x.api.vendor.com.", dns.TypeA, false, 0},
+		{"parent_not_overridden", "vendor.com.", dns.TypeA, false, 0},
+		{"other_type_forwarded", "api.vendor.com.", dns.TypeMX, false, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &dns.Msg{}
+			r.SetQuestion(tt.qname, tt.qtype)
+			msg, ok := h.Lookup(r)
+			if ok != tt.found {
+				t.Fatalf("Lookup() found = %v, want %v", ok, tt.found)
+			}
+			if ok && len(msg.Answer) != tt.answers {
+				t.Errorf("Lookup() answers = %v, want %v", msg.Answer, tt.answers)
+			}
+		})
+	}
+
+	// changed file is reloaded
+	later := time.Now().Add(time.Minute)
+	if err := os.WriteFile(file, []byte("192.0.2.11 other.vendor.com\n"), 0o600); err != nil {
+		t.Fatalf("write hosts: %v", err)
+	}
+	if err := os.Chtimes(file, later, later); err != nil {
+		t.Fatalf("chtimes: %v", err)
+	}
+	if err := h.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if md := h.Get("api.vendor.com."); md.Name != "" {
+		t.Errorf("Get() after reload = %v, want empty", md)
+	}
+	if md := h.Get("other.vendor.com"); len(md.Ipv4s) != 1 {
+		t.Errorf("Get() after reload = %v", md)
+	}
+}
+
+func TestHosts_Set(t *testing.T) {
+	tests := []struct {
+		name    string
+		md      models.Override
+		wantErr bool
+	}{
+		{"valid", models.Override{Name: "a.example.", Ipv4s: []string{"192.0.2.1"}, Ipv6s: []string{"2001:db8::1"}}, false},
+		{"empty_name", models.Override{Ipv4s: []string{"192.0.2.1"}}, true},
+		{"ipv6_as_ipv4", models.Override{Name: "a.example.", Ipv4s: []string{"2001:db8::1"}}, true},
+		{"ipv4_as_ipv6", models.Override{Name: "a.example.", Ipv6s: []string{"192.0.2.1"}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := NewHosts("").Set(&tt.md); (err != nil) != tt.wantErr {
+				t.Errorf("Set() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,384 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// rootHints addresses of the root servers a.root-servers.net - m.root-servers.net
+var rootHints = []string{
+	"198.41.0.4",
+	"199.9.14.201",
+	"192.33.4.12",
+	"199.7.91.13",
+	"192.203.230.10",
+	"192.5.5.241",
+	"192.112.36.4",
+	"198.97.190.53",
+	"192.36.148.17",
+	"192.58.128.30",
+	"193.0.14.129",
+	"199.7.83.42",
+	"202.12.27.33",
+}
+
+const (
+	// maxDepth limit of nested resolutions (cname chains, addresses of name servers)
+	maxDepth = 8
+	// maxSteps limit of queries for one name
+	maxSteps = 32
+	// delegationCacheSize limit of cached zone cuts, expired ones are removed first when it is reached
+	delegationCacheSize = 10000
+)
+
+var (
+	errMaxDepth = errors.New("iterative: max depth of resolution exceeded")
+	errMaxSteps = errors.New("iterative: too many steps for one name")
+	errNoServer = errors.New("iterative: no name servers answered")
+)
+
+// delegation name servers of zone with time of expiration
+type delegation struct {
+	addrs  []string
+	expire time.Time
+}
+
+// Iterator resolves requests from the root, following delegations
+type Iterator struct {
+	Client   *dns.Client
+	TCP      *dns.Client
+	Roots    []string
+	Port     string
+	Minimise bool
+	cache    map[string]delegation
+	size     int
+	mux      sync.Mutex
+}
+
+// NewIterator simple constructor, with empty roots the root hints are used
+func NewIterator(roots []string, minimise bool) *Iterator {
+	if len(roots) == 0 {
+		roots = rootHints
+	}
+	return &Iterator{
+		Client: &dns.Client{
+			Net:     "udp",
+			Timeout: 2 * time.Second,
+		},
+		TCP: &dns.Client{
+			Net:     "tcp",
+			Timeout: 2 * time.Second,
+		},
+		Roots:    roots,
+		Port:     "53",
+		Minimise: minimise,
+		cache:    make(map[string]delegation),
+		size:     delegationCacheSize,
+	}
+}
+
+// Resolve answer on request like a recursive resolver
+func (it *Iterator) Resolve(ctx context.Context, req *dns.Msg) (*dns.Msg, error) {
+
+	q := req.Question[0]
+
+	r, err := it.resolve(ctx, dns.Fqdn(strings.ToLower(q.Name)), q.Qtype, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &dns.Msg{}
+	msg.SetReply(req)
+	msg.RecursionAvailable = true
+	msg.Rcode = r.Rcode
+	msg.Answer = r.Answer
+	msg.Ns = r.Ns
+	return msg, nil
+}
+
+// resolve name from closest known zone
+func (it *Iterator) resolve(ctx context.Context, name string, qtype uint16, depth int) (*dns.Msg, error) {
+
+	if depth > maxDepth {
+		return nil, errMaxDepth
+	}
+
+	zone, servers := it.closest(name)
+	// the deepest name which is known to be not a zone cut
+	known := zone
+
+	for step := 0; step < maxSteps; step++ {
+
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		qname, typ := name, qtype
+		if it.Minimise {
+			if qname = minimise(name, known); qname != name {
+				typ = dns.TypeNS
+			}
+		}
+
+		r, err := it.exchange(ctx, qname, typ, servers)
+		if err != nil {
+			return nil, err
+		}
+
+		// zone cut from referral or from authoritative ns answer
+		if cut, ns := referral(r, zone, qname); cut != "" {
+			if servers, err = it.delegate(ctx, cut, ns, r.Extra, depth); err != nil {
+				return nil, err
+			}
+			zone, known = cut, cut
+			continue
+		}
+
+		// minimised query, go one label deeper
+		if qname != name {
+			if r.Rcode == dns.RcodeNameError {
+				return r, nil
+			}
+			known = qname
+			continue
+		}
+
+		return it.chase(ctx, r, name, qtype, depth)
+	}
+
+	return nil, errMaxSteps
+}
+
+// chase follow cname if answer has no records of requested type
+func (it *Iterator) chase(ctx context.Context, r *dns.Msg, name string, qtype uint16, depth int) (*dns.Msg, error) {
+
+	if qtype == dns.TypeCNAME || len(r.Answer) == 0 {
+		return r, nil
+	}
+
+	target := name
+	for _, rr := range r.Answer {
+		if rr.Header().Rrtype == qtype {
+			return r, nil
+		}
+		if c, ok := rr.(*dns.CNAME); ok && strings.EqualFold(c.Hdr.Name, target) {
+			target = strings.ToLower(c.Target)
+		}
+	}
+
+	if target == name {
+		return r, nil
+	}
+
+	next, err := it.resolve(ctx, target, qtype, depth+1)
+	if err != nil {
+		return nil, err
+	}
+
+	next.Answer = append(r.Answer, next.Answer...)
+	return next, nil
+}
+
+// delegate find addresses of name servers for zone cut and cache them
+func (it *Iterator) delegate(ctx context.Context, zone string, ns []*dns.NS, extra []dns.RR, depth int) ([]string, error) {
+
+	var (
+		v4, v6 []string
+		ttl    uint32
+	)
+
+	names := make(map[string]bool)
+	for i, v := range ns {
+		names[strings.ToLower(v.Ns)] = true
+		if i == 0 || v.Hdr.Ttl < ttl {
+			ttl = v.Hdr.Ttl
+		}
+	}
+
+	// glue only for name servers of this delegation
+	for _, rr := range extra {
+		if !names[strings.ToLower(rr.Header().Name)] {
+			continue
+		}
+		switch v := rr.(type) {
+		case *dns.A:
+			v4 = append(v4, v.A.String())
+		case *dns.AAAA:
+			v6 = append(v6, v.AAAA.String())
+		}
+	}
+
+	addrs := append(v4, v6...)
+
+	// without glue resolve names of servers, but never inside the zone itself,
+	// AAAA when name server has no A records
+	if len(addrs) == 0 {
+		for n := range names {
+			if dns.IsSubDomain(zone, n) {
+				continue
+			}
+			for _, typ := range []uint16{dns.TypeA, dns.TypeAAAA} {
+				r, err := it.resolve(ctx, n, typ, depth+1)
+				if err != nil {
+					continue
+				}
+				for _, rr := range r.Answer {
+					switch v := rr.(type) {
+					case *dns.A:
+						addrs = append(addrs, v.A.String())
+					case *dns.AAAA:
+						addrs = append(addrs, v.AAAA.String())
+					}
+				}
+				if len(addrs) > 0 {
+					break
+				}
+			}
+			if len(addrs) > 0 {
+				break
+			}
+		}
+	}
+
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("iterative: no addresses for name servers of %s", zone)
+	}
+
+	it.store(zone, delegation{
+		addrs:  addrs,
+		expire: time.Now().Add(time.Duration(ttl) * time.Second),
+	})
+
+	return addrs, nil
+}
+
+// store cache delegation of zone, full cache drops expired and then any other zones
+func (it *Iterator) store(zone string, d delegation) {
+
+	it.mux.Lock()
+	defer it.mux.Unlock()
+
+	if _, ok := it.cache[zone]; !ok && len(it.cache) >= it.size {
+		now := time.Now()
+		for k, v := range it.cache {
+			if !now.Before(v.expire) {
+				delete(it.cache, k)
+			}
+		}
+		for k := range it.cache {
+			if len(it.cache) < it.size {
+				break
+			}
+			delete(it.cache, k)
+		}
+	}
+
+	it.cache[zone] = d
+}
+
+// closest find the deepest cached zone for name
+func (it *Iterator) closest(name string) (string, []string) {
+
+	it.mux.Lock()
+	defer it.mux.Unlock()
+
+	now := time.Now()
+	for off, end := 0, false; !end; off, end = dns.NextLabel(name, off) {
+		zone := name[off:]
+		if d, ok := it.cache[zone]; ok {
+			if now.Before(d.expire) {
+				return zone, d.addrs
+			}
+			delete(it.cache, zone)
+		}
+	}
+
+	return ".", it.Roots
+}
+
+// exchange send not recursive query to one of servers
+func (it *Iterator) exchange(ctx context.Context, name string, qtype uint16, servers []string) (*dns.Msg, error) {
+
+	req := &dns.Msg{}
+	req.SetQuestion(name, qtype)
+	req.RecursionDesired = false
+
+	for _, v := range servers {
+
+		addr := net.JoinHostPort(v, it.Port)
+
+		r, _, err := it.Client.ExchangeContext(ctx, req, addr)
+		if err == nil && r.Truncated {
+			r, _, err = it.TCP.ExchangeContext(ctx, req, addr)
+		}
+		if err != nil {
+			continue
+		}
+
+		if r.Rcode == dns.RcodeSuccess || r.Rcode == dns.RcodeNameError {
+			return r, nil
+		}
+	}
+
+	return nil, errNoServer
+}
+
+// referral find delegation below zone in response, returns zone cut and its name servers
+func referral(r *dns.Msg, zone, qname string) (string, []*dns.NS) {
+
+	var (
+		cut string
+		ns  []*dns.NS
+	)
+
+	// authoritative answer on minimised ns query is also a zone cut
+	answer := len(r.Answer) > 0
+	section := r.Ns
+	if answer {
+		section = r.Answer
+	}
+
+	for _, rr := range section {
+		v, ok := rr.(*dns.NS)
+		if !ok {
+			if answer {
+				return "", nil
+			}
+			continue
+		}
+		owner := strings.ToLower(v.Hdr.Name)
+		if cut != "" && owner != cut {
+			continue
+		}
+		cut = owner
+		ns = append(ns, v)
+	}
+
+	if cut == "" || cut == zone {
+		return "", nil
+	}
+
+	// only downward referrals inside the queried name
+	if !dns.IsSubDomain(zone, cut) || !dns.IsSubDomain(cut, qname) {
+		return "", nil
+	}
+
+	return cut, ns
+}
+
+// minimise name to one label below zone
+func minimise(name, zone string) string {
+	labels := dns.SplitDomainName(name)
+	n := dns.CountLabel(zone) + 1
+	if n >= len(labels) {
+		return name
+	}
+	return dns.Fqdn(strings.Join(labels[len(labels)-n:], "."))
+}
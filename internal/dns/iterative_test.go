@@ -0,0 +1,246 @@
+package dns
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// startZone start udp server on addr answering with fn, returns port of started server
+func startZone(t *testing.T, addr string, fn dns.HandlerFunc) string {
+	started := make(chan struct{})
+	errs := make(chan error, 1)
+	srv := &dns.Server{Addr: addr, Net: "udp", Handler: fn, NotifyStartedFunc: func() { close(started) }}
+	go func() {
+		errs <- srv.ListenAndServe()
+	}()
+	select {
+	case <-started:
+	case err := <-errs:
+		t.Skipf("listen %v: %v", addr, err)
+	}
+	t.Cleanup(func() {
+		_ = srv.Shutdown()
+	})
+	_, port, _ := net.SplitHostPort(srv.PacketConn.LocalAddr().String())
+	return port
+}
+
+func TestIterator_Resolve(t *testing.T) {
+
+	var (
+		mux   sync.Mutex
+		roots []string
+	)
+
+	// root delegates example. to 127.0.0.2 with glue, both listen on the same port
+	port := startZone(t, "127.0.0.1:0", func(w dns.ResponseWriter, r *dns.Msg) {
+		mux.Lock()
+		roots = append(roots, r.Question[0].Name)
+		mux.Unlock()
+		m := &dns.Msg{}
+		m.SetReply(r)
+		m.Ns = append(m.Ns, &dns.NS{
+			Hdr: dns.RR_Header{Name: "example.", Rrtype: dns.TypeNS, Class: dns.ClassINET, Ttl: 60},
+			Ns:  "ns.example.",
+		})
+		m.Extra = append(m.Extra, &dns.A{
+			Hdr: dns.RR_Header{Name: "ns.example.", Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60},
+			A:   net.ParseIP("127.0.0.2"),
+		})
+		_ = w.WriteMsg(m)
+	})
+
+	// example. is authoritative for www and alias
+	startZone(t, "127.0.0.2:"+port, func(w dns.ResponseWriter, r *dns.Msg) {
+		m := &dns.Msg{}
+		m.SetReply(r)
+		m.Authoritative = true
+		q := r.Question[0]
+		switch {
+		case q.Name == "www.example." && q.Qtype == dns.TypeA:
+			m.Answer = append(m.Answer, &dns.A{
+				Hdr: dns.RR_Header{Name: q.Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60},
+				A:   net.ParseIP("192.0.2.1"),
+			})
+		case q.Name == "alias.example." && q.Qtype == dns.TypeA:
+			m.Answer = append(m.Answer, &dns.CNAME{
+				Hdr:    dns.RR_Header{Name: q.Name, Rrtype: dns.TypeCNAME, Class: dns.ClassINET, Ttl: 60},
+				Target: "www.example.",
+			})
+		case q.Name == "www.example." || q.Name == "alias.example.":
+		default:
+			m.Rcode = dns.RcodeNameError
+		}
+		_ = w.WriteMsg(m)
+	})
+
+	tests := []struct {
+		name    string
+		qname   string
+		rcode   int
+		answers int
+	}{
+		{"delegation_with_glue", "www.example.", dns.RcodeSuccess, 1},
+		{"cname_chain", "alias.example.", dns.RcodeSuccess, 2},
+		{"nxdomain", "deep.missing.example.", dns.RcodeNameError, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			it := NewIterator([]string{"127.0.0.1"}, true)
+			it.Port = port
+
+			req := &dns.Msg{}
+			req.SetQuestion(tt.qname, dns.TypeA)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+			defer cancel()
+
+			got, err := it.Resolve(ctx, req)
+			if err != nil {
+				t.Fatalf("Resolve() error = %v", err)
+			}
+			if got.Rcode != tt.rcode {
+				t.Errorf("Resolve() rcode = %v, want %v", got.Rcode, tt.rcode)
+			}
+			if len(got.Answer) != tt.answers {
+				t.Errorf("Resolve() answers = %v, want %v", got.Answer, tt.answers)
+			}
+			if got.Id != req.Id || !got.RecursionAvailable {
+				t.Errorf("Resolve() reply header = %v", got.MsgHdr)
+			}
+		})
+	}
+
+	// qname minimisation: the root never sees the full name
+	mux.Lock()
+	defer mux.Unlock()
+	for _, q := range roots {
+		if dns.CountLabel(q) > 1 {
+			t.Errorf("root received not minimised qname %v", q)
+		}
+	}
+}
+
+func TestMinimise(t *testing.T) {
+	tests := []struct {
+		name string
+		zone string
+		want string
+	}{
+		{"a.b.example.com.", ".", "com."},
+		{"a.b.example.com.", "com.", "example.com."},
+		{"a.b.example.com.", "b.example.com.", "a.b.example.com."},
+		{"example.com.", "example.com.", "example.com."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name+tt.zone, func(t *testing.T) {
+			if got := minimise(tt.name, tt.zone); got != tt.want {
+				t.Errorf("minimise() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIterator_Store(t *testing.T) {
+
+	it := NewIterator(nil, true)
+	it.size = 2
+
+	now := time.Now()
+	it.store("a.", delegation{addrs: []string{"192.0.2.1"}, expire: now.Add(-time.Second)})
+	it.store("b.", delegation{addrs: []string{"192.0.2.2"}, expire: now.Add(time.Hour)})
+
+	// expired zone goes first
+	it.store("c.", delegation{addrs: []string{"192.0.2.3"}, expire: now.Add(time.Hour)})
+	if _, ok := it.cache["a."]; ok || len(it.cache) != 2 {
+		t.Errorf("cache = %v", it.cache)
+	}
+
+	// full cache never grows
+	it.store("d.", delegation{addrs: []string{"192.0.2.4"}, expire: now.Add(time.Hour)})
+	if _, ok := it.cache["d."]; !ok || len(it.cache) != 2 {
+		t.Errorf("cache = %v", it.cache)
+	}
+
+	// replaced zone keeps others
+	it.store("d.", delegation{addrs: []string{"192.0.2.5"}, expire: now.Add(time.Hour)})
+	if len(it.cache) != 2 {
+		t.Errorf("cache = %v", it.cache)
+	}
+	if zone, addrs := it.closest("www.d."); zone != "d." || addrs[0] != "192.0.2.5" {
+		t.Errorf("closest() = %v %v", zone, addrs)
+	}
+}
+
+func TestIterator_GluelessIPv6(t *testing.T) {
+
+	// root delegates example. with glue and v6. to ns.example. without glue
+	port := startZone(t, "127.0.0.1:0", func(w dns.ResponseWriter, r *dns.Msg) {
+		m := &dns.Msg{}
+		m.SetReply(r)
+		zone, ns := "example.", "ns.example."
+		if dns.IsSubDomain("v6.", r.Question[0].Name) {
+			zone, ns = "v6.", "ns.example."
+		}
+		m.Ns = append(m.Ns, &dns.NS{
+			Hdr: dns.RR_Header{Name: zone, Rrtype: dns.TypeNS, Class: dns.ClassINET, Ttl: 60},
+			Ns:  ns,
+		})
+		if zone == "example." {
+			m.Extra = append(m.Extra, &dns.A{
+				Hdr: dns.RR_Header{Name: ns, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60},
+				A:   net.ParseIP("127.0.0.2"),
+			})
+		}
+		_ = w.WriteMsg(m)
+	})
+
+	// name server of v6. has only an ipv6 address
+	startZone(t, "127.0.0.2:"+port, func(w dns.ResponseWriter, r *dns.Msg) {
+		m := &dns.Msg{}
+		m.SetReply(r)
+		m.Authoritative = true
+		if q := r.Question[0]; q.Name == "ns.example." && q.Qtype == dns.TypeAAAA {
+			m.Answer = append(m.Answer, &dns.AAAA{
+				Hdr:  dns.RR_Header{Name: q.Name, Rrtype: dns.TypeAAAA, Class: dns.ClassINET, Ttl: 60},
+				AAAA: net.ParseIP("::1"),
+			})
+		}
+		_ = w.WriteMsg(m)
+	})
+
+	startZone(t, "[::1]:"+port, func(w dns.ResponseWriter, r *dns.Msg) {
+		m := &dns.Msg{}
+		m.SetReply(r)
+		m.Authoritative = true
+		if q := r.Question[0]; q.Name == "www.v6." && q.Qtype == dns.TypeA {
+			m.Answer = append(m.Answer, &dns.A{
+				Hdr: dns.RR_Header{Name: q.Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60},
+				A:   net.ParseIP("192.0.2.6"),
+			})
+		}
+		_ = w.WriteMsg(m)
+	})
+
+	it := NewIterator([]string{"127.0.0.1"}, true)
+	it.Port = port
+
+	req := &dns.Msg{}
+	req.SetQuestion("www.v6.", dns.TypeA)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	got, err := it.Resolve(ctx, req)
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	if len(got.Answer) != 1 {
+		t.Errorf("Resolve() answers = %v, want 1", got.Answer)
+	}
+}
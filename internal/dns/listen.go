@@ -0,0 +1,74 @@
+package dns
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/miekg/dns"
+)
+
+// protocols of listeners
+const (
+	protoUDP   = "udp"
+	protoTCP   = "tcp"
+	protoTLS   = "tls"
+	protoQUIC  = "quic"
+	protoHTTPS = "https"
+)
+
+// ListenAddrs addresses of protocol from definitions like "udp=[::1]:53",
+// without definitions of protocol all ipv4 addresses on port are used, empty port disables protocol
+func ListenAddrs(defs []string, proto, port string) ([]string, error) {
+
+	var addrs []string
+
+	for _, def := range defs {
+		kv := strings.SplitN(def, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("listen: invalid definition %q", def)
+		}
+		switch p := strings.ToLower(strings.TrimSpace(kv[0])); p {
+		case protoUDP, protoTCP, protoTLS, protoQUIC, protoHTTPS:
+			if p != proto {
+				continue
+			}
+		default:
+			return nil, fmt.Errorf("listen: unknown protocol %q", kv[0])
+		}
+		addr := strings.TrimSpace(kv[1])
+		host, _, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, fmt.Errorf("listen: invalid address %q: %v", addr, err)
+		}
+		// zone of link local address names interface, e.g. fe80::1%eth0
+		if ip, _, _ := strings.Cut(host, "%"); host != "" && net.ParseIP(ip) == nil {
+			return nil, fmt.Errorf("listen: invalid ip %q", host)
+		}
+		addrs = append(addrs, addr)
+	}
+
+	if len(addrs) == 0 && port != "" {
+		addrs = append(addrs, net.JoinHostPort("0.0.0.0", port))
+	}
+
+	return addrs, nil
+}
+
+// udp reply goes over plain udp, quic connections are validated by handshake
+func udp(w dns.ResponseWriter) bool {
+	if _, ok := w.(*doqWriter); ok {
+		return false
+	}
+	_, ok := w.LocalAddr().(*net.UDPAddr)
+	return ok
+}
+
+// remoteIP address of client, nil when it is unknown
+func remoteIP(w dns.ResponseWriter) net.IP {
+	host, _, err := net.SplitHostPort(w.RemoteAddr().String())
+	if err != nil {
+		return nil
+	}
+	return net.ParseIP(host)
+}
@@ -0,0 +1,40 @@
+package dns
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestListenAddrs(t *testing.T) {
+
+	defs := []string{"udp=[::]:53", "udp=192.0.2.1:53", "tcp=[fe80::1%eth0]:53", "tls=:853"}
+
+	tests := []struct {
+		name    string
+		defs    []string
+		proto   string
+		port    string
+		want    []string
+		wantErr bool
+	}{
+		{"ipv6_and_ipv4", defs, protoUDP, "53", []string{"[::]:53", "192.0.2.1:53"}, false},
+		{"link_local_zone", defs, protoTCP, "53", []string{"[fe80::1%eth0]:53"}, false},
+		{"all_addresses", defs, protoTLS, "", []string{":853"}, false},
+		{"default_port", defs, protoQUIC, "853", []string{"0.0.0.0:853"}, false},
+		{"disabled", defs, protoHTTPS, "", nil, false},
+		{"unknown_protocol", []string{"sctp=[::]:53"}, protoUDP, "53", nil, true},
+		{"missing_port", []string{"udp=::1"}, protoUDP, "53", nil, true},
+		{"hostname", []string{"udp=localhost:53"}, protoUDP, "53", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ListenAddrs(tt.defs, tt.proto, tt.port)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ListenAddrs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ListenAddrs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,357 @@
+package dns
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	"github.com/miekg/dns"
+)
+
+// spfLookups limit of terms of SPF record which cause dns lookups (RFC 7208 section 4.6.4)
+const spfLookups = 10
+
+// qualifiers of all mechanism of SPF, +all would authorize every host to send mail of zone
+var spfQualifiers = map[string]string{
+	"fail":     "-",
+	"softfail": "~",
+	"neutral":  "?",
+}
+
+// spfDomain check domain-spec of term, macros are expanded by the receiver and are not checked
+func spfDomain(term, domain string) error {
+	if domain == "" || strings.Contains(domain, "%") {
+		return nil
+	}
+	if _, ok := dns.IsDomainName(domain); !ok {
+		return fmt.Errorf("spf: invalid domain of %q", term)
+	}
+	return nil
+}
+
+// spfCIDR check dual cidr length like /24, //64 or /24//64 of a and mx mechanisms
+func spfCIDR(term, cidr string) error {
+	if cidr == "" {
+		return nil
+	}
+	v4, v6, dual := strings.Cut(cidr, "//")
+	for _, v := range []struct {
+		length string
+		max    int
+		set    bool
+	}{{strings.TrimPrefix(v4, "/"), 32, v4 != ""}, {v6, 128, dual}} {
+		if !v.set {
+			continue
+		}
+		if n, err := strconv.Atoi(v.length); err != nil || n < 0 || n > v.max {
+			return fmt.Errorf("spf: invalid cidr length of %q", term)
+		}
+	}
+	return nil
+}
+
+// spfTerm check term of SPF record, true when it causes a dns lookup
+func spfTerm(term string) (bool, error) {
+
+	v := strings.ToLower(term)
+	qualified := v != "" && strings.ContainsAny(v[:1], "+-~?")
+	if qualified {
+		v = v[1:]
+	}
+
+	name, arg := v, ""
+	if i := strings.IndexAny(v, ":=/"); i >= 0 {
+		name, arg = v[:i], v[i:]
+	}
+
+	switch name {
+	case "all":
+		return false, fmt.Errorf("spf: %q is set by qualifier", term)
+	case "include", "exists":
+		if !strings.HasPrefix(arg, ":") || len(arg) == 1 {
+			return false, fmt.Errorf("spf: %q needs domain", term)
+		}
+		return true, spfDomain(term, arg[1:])
+	case "a", "mx":
+		domain, cidr := "", arg
+		if strings.HasPrefix(arg, ":") {
+			domain, cidr, _ = strings.Cut(arg[1:], "/")
+			if cidr != "" {
+				cidr = "/" + cidr
+			}
+		}
+		if err := spfDomain(term, domain); err != nil {
+			return false, err
+		}
+		return true, spfCIDR(term, cidr)
+	case "ptr":
+		if arg != "" && !strings.HasPrefix(arg, ":") {
+			return false, fmt.Errorf("spf: invalid term %q", term)
+		}
+		return true, spfDomain(term, strings.TrimPrefix(arg, ":"))
+	case "ip4", "ip6":
+		addr := strings.TrimPrefix(arg, ":")
+		if !strings.Contains(addr, "/") {
+			addr += map[string]string{"ip4": "/32", "ip6": "/128"}[name]
+		}
+		ip, _, err := net.ParseCIDR(addr)
+		if err != nil || !strings.HasPrefix(arg, ":") || (ip.To4() != nil) != (name == "ip4") {
+			return false, fmt.Errorf("spf: invalid address of %q", term)
+		}
+		return false, nil
+	case "redirect", "exp":
+		if qualified || !strings.HasPrefix(arg, "=") || len(arg) == 1 {
+			return false, fmt.Errorf("spf: invalid modifier %q", term)
+		}
+		return name == "redirect", spfDomain(term, arg[1:])
+	}
+
+	return false, fmt.Errorf("spf: unknown term %q", term)
+}
+
+// ParseSPF build SPF record of zone from policy and check it, also the limit of dns lookups;
+// without mechanisms and includes the addresses of zone, a and mx are allowed
+func ParseSPF(entry *models.DNSEntry, md *models.SpfPolicy) (string, error) {
+
+	if md == nil {
+		md = &models.SpfPolicy{}
+	}
+
+	var terms []string
+	for _, v := range md.Mechanisms {
+		if v = strings.TrimSpace(v); v != "" {
+			terms = append(terms, v)
+		}
+	}
+	for _, v := range md.Includes {
+		if v = strings.TrimSuffix(strings.TrimSpace(v), "."); v != "" {
+			terms = append(terms, "include:"+v)
+		}
+	}
+	if len(terms) == 0 {
+		for _, v := range entry.Ipv4s {
+			terms = append(terms, "ip4:"+v)
+		}
+		for _, v := range entry.Ipv6s {
+			terms = append(terms, "ip6:"+v)
+		}
+		terms = append(terms, "a", "mx")
+	}
+
+	lookups, redirect := 0, false
+	for _, v := range terms {
+		lookup, err := spfTerm(v)
+		if err != nil {
+			return "", err
+		}
+		if lookup {
+			lookups++
+		}
+		redirect = redirect || strings.HasPrefix(strings.ToLower(v), "redirect=")
+	}
+	if lookups > spfLookups {
+		return "", fmt.Errorf("spf: %v terms need dns lookups, limit is %v", lookups, spfLookups)
+	}
+
+	qualifier := "softfail"
+	if md.Qualifier != "" {
+		qualifier = md.Qualifier
+	}
+	q, ok := spfQualifiers[qualifier]
+	if !ok {
+		return "", fmt.Errorf("spf: invalid qualifier %q", md.Qualifier)
+	}
+
+	// redirect is ignored when the record has all
+	if !redirect {
+		terms = append(terms, q+"all")
+	}
+
+	return "v=spf1 " + strings.Join(terms, " "), nil
+}
+
+// dmarcURIs report addresses as mailto URIs, a size limit like !10m is kept
+func dmarcURIs(tag string, addrs []string) (string, error) {
+	var uris []string
+	for _, v := range addrs {
+		v = strings.TrimSpace(v)
+		addr := strings.TrimPrefix(v, "mailto:")
+		if i := strings.LastIndex(addr, "!"); i >= 0 {
+			size := strings.TrimRight(addr[i+1:], "kmgt")
+			if _, err := strconv.ParseUint(size, 10, 64); err != nil || size == "" {
+				return "", fmt.Errorf("dmarc: invalid size limit of %s %q", tag, v)
+			}
+			addr = addr[:i]
+		}
+		if md, err := mail.ParseAddress(addr); err != nil || md.Address != addr || strings.ContainsAny(addr, ",;") {
+			return "", fmt.Errorf("dmarc: invalid address of %s %q", tag, v)
+		}
+		uris = append(uris, "mailto:"+strings.TrimPrefix(v, "mailto:"))
+	}
+	return strings.Join(uris, ","), nil
+}
+
+// ParseDMARC build DMARC record of zone from policy and check it, reports go to admin of zone without policy
+func ParseDMARC(entry *models.DNSEntry, md *models.DmarcPolicy) (string, error) {
+
+	if md == nil {
+		return "v=DMARC1; p=none; sp=none; rua=mailto:admin@" + strings.TrimSuffix(entry.Domain, "."), nil
+	}
+
+	policies := map[string]bool{"none": true, "quarantine": true, "reject": true}
+	alignments := map[string]string{"relaxed": "r", "strict": "s"}
+
+	p := "none"
+	if md.Policy != "" {
+		p = md.Policy
+	}
+	if !policies[p] {
+		return "", fmt.Errorf("dmarc: invalid policy %q", md.Policy)
+	}
+	tags := []string{"v=DMARC1", "p=" + p}
+
+	if md.SubdomainPolicy != "" {
+		if !policies[md.SubdomainPolicy] {
+			return "", fmt.Errorf("dmarc: invalid subdomain policy %q", md.SubdomainPolicy)
+		}
+		tags = append(tags, "sp="+md.SubdomainPolicy)
+	}
+
+	if md.Pct != nil {
+		if *md.Pct < 0 || *md.Pct > 100 {
+			return "", fmt.Errorf("dmarc: pct %v out of 0 to 100", *md.Pct)
+		}
+		tags = append(tags, fmt.Sprintf("pct=%d", *md.Pct))
+	}
+
+	for _, v := range []struct {
+		tag   string
+		addrs []string
+	}{{"rua", md.Rua}, {"ruf", md.Ruf}} {
+		if len(v.addrs) == 0 {
+			continue
+		}
+		uris, err := dmarcURIs(v.tag, v.addrs)
+		if err != nil {
+			return "", err
+		}
+		tags = append(tags, v.tag+"="+uris)
+	}
+
+	for _, v := range []struct {
+		tag, value string
+	}{{"adkim", md.Adkim}, {"aspf", md.Aspf}} {
+		if v.value == "" {
+			continue
+		}
+		a, ok := alignments[v.value]
+		if !ok {
+			return "", fmt.Errorf("dmarc: invalid %s %q", v.tag, v.value)
+		}
+		tags = append(tags, v.tag+"="+a)
+	}
+
+	return strings.Join(tags, "; "), nil
+}
+
+// mtaSTSMaxAge default seconds senders cache MTA-STS policy, one week as RFC 8461 suggests
+const mtaSTSMaxAge = 604800
+
+// ParseMTASTS build MTA-STS policy file of zone and id of _mta-sts record from policy and check it;
+// id follows the content, so senders fetch the policy again when it changes
+func ParseMTASTS(entry *models.DNSEntry, md *models.MtaStsPolicy) (string, string, error) {
+
+	mode := "testing"
+	if md.Mode != "" {
+		mode = md.Mode
+	}
+	switch mode {
+	case "enforce", "testing", "none":
+	default:
+		return "", "", fmt.Errorf("mta-sts: invalid mode %q", md.Mode)
+	}
+
+	age := int64(mtaSTSMaxAge)
+	if md.MaxAge != nil {
+		age = *md.MaxAge
+	}
+	if age < 0 || age > 31557600 {
+		return "", "", fmt.Errorf("mta-sts: max age %v out of 0 to 31557600", age)
+	}
+
+	mx := md.Mx
+	if len(mx) == 0 {
+		mx = []string{"mail." + entry.Domain}
+	}
+
+	lines := []string{"version: STSv1", "mode: " + mode}
+	for _, v := range mx {
+		v = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(v)), ".")
+		if _, ok := dns.IsDomainName(strings.TrimPrefix(v, "*.")); !ok || v == "" || strings.Contains(strings.TrimPrefix(v, "*."), "*") {
+			return "", "", fmt.Errorf("mta-sts: invalid mx %q", v)
+		}
+		lines = append(lines, "mx: "+v)
+	}
+	lines = append(lines, fmt.Sprintf("max_age: %d", age))
+
+	policy := strings.Join(lines, "\r\n") + "\r\n"
+	sum := sha256.Sum256([]byte(policy))
+
+	return policy, hex.EncodeToString(sum[:10]), nil
+}
+
+// ParseTLSRPT build TLS reporting record from policy and check it, plain addresses become mailto URIs
+func ParseTLSRPT(md *models.TLSRptPolicy) (string, error) {
+
+	var uris []string
+	for _, v := range md.Rua {
+		v = strings.TrimSpace(v)
+		if strings.HasPrefix(v, "https:") {
+			if u, err := url.Parse(v); err != nil || u.Host == "" || strings.ContainsAny(v, ",;!") {
+				return "", fmt.Errorf("tls-rpt: invalid rua %q", v)
+			}
+			uris = append(uris, v)
+			continue
+		}
+		addr := strings.TrimPrefix(v, "mailto:")
+		if a, err := mail.ParseAddress(addr); err != nil || a.Address != addr || strings.ContainsAny(addr, ",;!") {
+			return "", fmt.Errorf("tls-rpt: invalid rua %q", v)
+		}
+		uris = append(uris, "mailto:"+addr)
+	}
+	if len(uris) == 0 {
+		return "", errors.New("tls-rpt: rua required")
+	}
+
+	return "v=TLSRPTv1; rua=" + strings.Join(uris, ","), nil
+}
+
+// ParseBIMI build BIMI record from policy and check it, location and authority are https URLs
+func ParseBIMI(md *models.BimiPolicy) (string, error) {
+
+	for _, v := range []struct {
+		tag, value, ext string
+	}{{"location", md.Location, ".svg"}, {"authority", md.Authority, ".pem"}} {
+		if v.value == "" && v.tag == "authority" {
+			continue
+		}
+		u, err := url.Parse(v.value)
+		if err != nil || u.Scheme != "https" || u.Host == "" || !strings.HasSuffix(strings.ToLower(u.Path), v.ext) ||
+			strings.ContainsAny(v.value, ";") {
+			return "", fmt.Errorf("bimi: %s must be https URL of %s file", v.tag, v.ext)
+		}
+	}
+
+	record := "v=BIMI1; l=" + md.Location
+	if md.Authority != "" {
+		record += "; a=" + md.Authority
+	}
+	return record, nil
+}
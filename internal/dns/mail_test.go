@@ -0,0 +1,223 @@
+package dns
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	"github.com/miekg/dns"
+)
+
+func TestParseSPF(t *testing.T) {
+
+	entry := &models.DNSEntry{Domain: "example.com.", Ipv4s: []string{"192.0.2.1"}, Ipv6s: []string{"2001:db8::1"}}
+	includes := func(n int) []string {
+		var v []string
+		for i := 0; i < n; i++ {
+			v = append(v, "_spf"+string(rune('a'+i))+".example.net")
+		}
+		return v
+	}
+
+	tests := []struct {
+		name string
+		md   *models.SpfPolicy
+		want string
+	}{
+		{"default", nil, "v=spf1 ip4:192.0.2.1 ip6:2001:db8::1 a mx ~all"},
+		{"policy", &models.SpfPolicy{
+			Mechanisms: []string{"mx", "ip4:198.51.100.0/24", "ip6:2001:db8::/32", "a:mail.example.com/24//64", "exists:%{i}._ip.example.com"},
+			Includes:   []string{"_spf.google.com."},
+			Qualifier:  "fail",
+		}, "v=spf1 mx ip4:198.51.100.0/24 ip6:2001:db8::/32 a:mail.example.com/24//64 exists:%{i}._ip.example.com include:_spf.google.com -all"},
+		{"redirect", &models.SpfPolicy{Mechanisms: []string{"redirect=_spf.example.com"}}, "v=spf1 redirect=_spf.example.com"},
+		{"ten lookups", &models.SpfPolicy{Includes: includes(10), Mechanisms: []string{"ip4:192.0.2.0/24", "exp=explain.example.com"}}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSPF(entry, tt.md)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.want != "" && got != tt.want {
+				t.Errorf("ParseSPF() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	bad := []*models.SpfPolicy{
+		{Includes: includes(11)},
+		{Includes: includes(9), Mechanisms: []string{"a", "mx"}},
+		{Mechanisms: []string{"-all"}},
+		{Mechanisms: []string{"ip4:2001:db8::1"}},
+		{Mechanisms: []string{"ip6:192.0.2.1"}},
+		{Mechanisms: []string{"ip4:192.0.2.0/33"}},
+		{Mechanisms: []string{"a/24//129"}},
+		{Mechanisms: []string{"include"}},
+		{Mechanisms: []string{"-redirect=example.com"}},
+		{Mechanisms: []string{"foo:example.com"}},
+		{Qualifier: "reject"},
+		{Qualifier: "pass"},
+		{Mechanisms: []string{"+all"}},
+	}
+	for _, v := range bad {
+		if got, err := ParseSPF(entry, v); err == nil {
+			t.Errorf("ParseSPF(%+v) = %v without error", v, got)
+		}
+	}
+}
+
+func TestParseDMARC(t *testing.T) {
+
+	entry := &models.DNSEntry{Domain: "example.com."}
+	pct := int64(50)
+
+	tests := []struct {
+		name string
+		md   *models.DmarcPolicy
+		want string
+	}{
+		{"default", nil, "v=DMARC1; p=none; sp=none; rua=mailto:admin@example.com"},
+		{"empty", &models.DmarcPolicy{}, "v=DMARC1; p=none"},
+		{"policy", &models.DmarcPolicy{
+			Policy:          "reject",
+			SubdomainPolicy: "quarantine",
+			Pct:             &pct,
+			Rua:             []string{"dmarc@example.com", "mailto:reports@example.net!10m"},
+			Ruf:             []string{"forensic@example.com"},
+			Adkim:           "strict",
+			Aspf:            "relaxed",
+		}, "v=DMARC1; p=reject; sp=quarantine; pct=50; rua=mailto:dmarc@example.com,mailto:reports@example.net!10m; ruf=mailto:forensic@example.com; adkim=s; aspf=r"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDMARC(entry, tt.md)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseDMARC() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	over := int64(101)
+	bad := []*models.DmarcPolicy{
+		{Policy: "block"},
+		{SubdomainPolicy: "allow"},
+		{Pct: &over},
+		{Rua: []string{"not an address"}},
+		{Rua: []string{"a@example.com;p=none"}},
+		{Ruf: []string{"a@example.com!big"}},
+		{Adkim: "s"},
+	}
+	for _, v := range bad {
+		if got, err := ParseDMARC(entry, v); err == nil {
+			t.Errorf("ParseDMARC(%+v) = %v without error", v, got)
+		}
+	}
+}
+
+func TestMailPolicyTXT(t *testing.T) {
+
+	s := &DNS{}
+	entry := &models.DNSEntry{
+		Domain: "example.com.",
+		Ipv4s:  []string{"192.0.2.1"},
+		Mail: &models.MailPolicy{
+			Spf:   &models.SpfPolicy{Includes: []string{"_spf.example.net"}, Qualifier: "fail"},
+			Dmarc: &models.DmarcPolicy{Policy: "quarantine"},
+		},
+	}
+
+	for name, want := range map[string]string{
+		"example.com.":        "v=spf1 include:_spf.example.net -all",
+		"_dmarc.example.com.": "v=DMARC1; p=quarantine",
+	} {
+		msg := &dns.Msg{}
+		msg.SetQuestion(name, dns.TypeTXT)
+		s.answer(msg, entry, nil, nil)
+		if len(msg.Answer) != 1 {
+			t.Fatalf("TXT %v = %v records, want 1", name, len(msg.Answer))
+		}
+		if got := strings.Join(msg.Answer[0].(*dns.TXT).Txt, ""); got != want {
+			t.Errorf("TXT %v = %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestParseMTASTS(t *testing.T) {
+
+	entry := &models.DNSEntry{Domain: "example.com."}
+	age := int64(86400)
+
+	policy, id, err := ParseMTASTS(entry, &models.MtaStsPolicy{MaxAge: &age})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "version: STSv1\r\nmode: testing\r\nmx: mail.example.com\r\nmax_age: 86400\r\n"; policy != want {
+		t.Errorf("policy = %q, want %q", policy, want)
+	}
+	if len(id) == 0 || len(id) > 32 {
+		t.Errorf("id = %v", id)
+	}
+
+	over := int64(31557601)
+	bad := []*models.MtaStsPolicy{
+		{Mode: "strict"},
+		{MaxAge: &over},
+		{Mx: []string{"mail.*.example.com"}},
+		{Mx: []string{"mail..example.com"}},
+	}
+	for _, v := range bad {
+		if _, _, err := ParseMTASTS(entry, v); err == nil {
+			t.Errorf("ParseMTASTS(%+v) without error", v)
+		}
+	}
+}
+
+func TestParseTLSRPT(t *testing.T) {
+
+	got, err := ParseTLSRPT(&models.TLSRptPolicy{Rua: []string{"tls@example.com", "https://reports.example.com/tls"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "v=TLSRPTv1; rua=mailto:tls@example.com,https://reports.example.com/tls"; got != want {
+		t.Errorf("ParseTLSRPT() = %v, want %v", got, want)
+	}
+
+	for _, v := range [][]string{nil, {"tls"}, {"https://"}, {"a@example.com,b@example.com"}} {
+		if _, err := ParseTLSRPT(&models.TLSRptPolicy{Rua: v}); err == nil {
+			t.Errorf("ParseTLSRPT(%v) without error", v)
+		}
+	}
+}
+
+func TestParseBIMI(t *testing.T) {
+
+	tests := []struct {
+		md   *models.BimiPolicy
+		want string
+	}{
+		{&models.BimiPolicy{Location: "https://example.com/logo.svg"}, "v=BIMI1; l=https://example.com/logo.svg"},
+		{&models.BimiPolicy{Location: "https://example.com/logo.svg", Authority: "https://example.com/vmc.pem"},
+			"v=BIMI1; l=https://example.com/logo.svg; a=https://example.com/vmc.pem"},
+	}
+	for _, tt := range tests {
+		if got, err := ParseBIMI(tt.md); err != nil || got != tt.want {
+			t.Errorf("ParseBIMI(%+v) = %v, %v, want %v", tt.md, got, err, tt.want)
+		}
+	}
+
+	bad := []*models.BimiPolicy{
+		{},
+		{Location: "http://example.com/logo.svg"},
+		{Location: "https://example.com/logo.png"},
+		{Location: "https://example.com/logo.svg", Authority: "https://example.com/vmc.crt"},
+	}
+	for _, v := range bad {
+		if _, err := ParseBIMI(v); err == nil {
+			t.Errorf("ParseBIMI(%+v) without error", v)
+		}
+	}
+}
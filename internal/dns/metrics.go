@@ -0,0 +1,58 @@
+package dns
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+)
+
+// metricsPath path of counters in prometheus text format
+const metricsPath = "/metrics"
+
+// metric one counter or gauge
+type metric struct {
+	name  string
+	kind  string
+	help  string
+	value int64
+}
+
+// Metrics handler of counters in prometheus text format, other paths go to next
+func (s *DNS) Metrics(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != metricsPath {
+			if next == nil {
+				http.NotFound(w, req)
+				return
+			}
+			next.ServeHTTP(w, req)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		if err := writeMetrics(w, s.metrics()); err != nil {
+			log.Printf("[ERR]: write metrics %v\n", err)
+		}
+	})
+}
+
+// metrics current values of counters
+func (s *DNS) metrics() []metric {
+	rrl := s.RRL.Get()
+	return []metric{
+		{"mdns_rrl_responses_total", "counter", "Udp responses checked by response rate limiting.", rrl.Responses},
+		{"mdns_rrl_dropped_total", "counter", "Udp responses dropped by response rate limiting.", rrl.Dropped},
+		{"mdns_rrl_slipped_total", "counter", "Udp responses sent truncated by response rate limiting.", rrl.Slipped},
+		{"mdns_rrl_exempted_total", "counter", "Udp responses of exempted clients.", rrl.Exempted},
+	}
+}
+
+// writeMetrics write metrics in prometheus text format
+func writeMetrics(w io.Writer, list []metric) error {
+	for _, m := range list {
+		if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n%s %d\n", m.name, m.help, m.name, m.kind, m.name, m.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
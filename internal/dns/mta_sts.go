@@ -0,0 +1,137 @@
+package dns
+
+import (
+	"errors"
+	"log"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/MarlikAlmighty/mdns/internal/data"
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	"github.com/miekg/dns"
+)
+
+// path of policy file of MTA-STS (RFC 8461 section 3.3), served at policy host mta-sts.<zone>
+const (
+	mtaSTSPath  = "/.well-known/mta-sts.txt"
+	mtaSTSLabel = "mta-sts."
+)
+
+var errMTASTSAddress = errors.New("mta-sts: invalid address of policy host")
+
+// MTASTS policy hosts of zones with MTA-STS policy, they answer with addresses of https listeners of mdns,
+// which serve the policy files
+type MTASTS struct {
+	ipv4s []string
+	ipv6s []string
+}
+
+// NewMTASTS simple constructor, without addresses policy hosts answer with addresses of their zone
+func NewMTASTS(addresses []string) (*MTASTS, error) {
+	m := &MTASTS{}
+	for _, v := range addresses {
+		ip := net.ParseIP(strings.TrimSpace(v))
+		switch {
+		case ip == nil:
+			return nil, errMTASTSAddress
+		case ip.To4() != nil:
+			m.ipv4s = append(m.ipv4s, ip.String())
+		default:
+			m.ipv6s = append(m.ipv6s, ip.String())
+		}
+	}
+	return m, nil
+}
+
+// host addresses of policy host of zone with name, nil for other names or without addresses
+func (m *MTASTS) host(entry *models.DNSEntry, name string) *models.NameServer {
+	if m == nil || len(m.ipv4s)+len(m.ipv6s) == 0 || entry.Mail == nil || entry.Mail.MtaSts == nil {
+		return nil
+	}
+	if strings.ToLower(name) != mtaSTSLabel+strings.ToLower(entry.Domain) {
+		return nil
+	}
+	return &models.NameServer{Name: name, Ipv4s: m.ipv4s, Ipv6s: m.ipv6s}
+}
+
+// WellKnown handler of policy files of MTA-STS by host of request on tls listeners, other paths go to next
+func (s *DNS) WellKnown(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != mtaSTSPath {
+			if next == nil {
+				http.NotFound(w, req)
+				return
+			}
+			next.ServeHTTP(w, req)
+			return
+		}
+		s.ServeMTASTS(w, req)
+	})
+}
+
+// ServeMTASTS answer policy file of zone of policy host mta-sts.<zone>, only over tls with the policy host
+// as server name, so that the certificate chosen for it is checked by the sender
+func (s *DNS) ServeMTASTS(w http.ResponseWriter, req *http.Request) {
+
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	host := req.Host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.ToLower(dns.Fqdn(host))
+	if req.TLS == nil || strings.ToLower(dns.Fqdn(req.TLS.ServerName)) != host || !strings.HasPrefix(host, mtaSTSLabel) {
+		http.NotFound(w, req)
+		return
+	}
+
+	entry := s.mtaSTSZone(req, strings.TrimPrefix(host, mtaSTSLabel))
+	if entry == nil {
+		http.NotFound(w, req)
+		return
+	}
+
+	policy, _, err := ParseMTASTS(entry, entry.Mail.MtaSts)
+	if err != nil {
+		http.NotFound(w, req)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	if _, err = w.Write([]byte(policy)); err != nil {
+		log.Printf("[ERR]: write mta-sts policy %v\n", err)
+	}
+}
+
+// mtaSTSZone zone with MTA-STS policy, from view of client first, then from default zones and other views
+func (s *DNS) mtaSTSZone(req *http.Request, domain string) *models.DNSEntry {
+
+	if s.Resolver == nil {
+		return nil
+	}
+
+	var sets []*data.ResolvedData
+	if s.Views != nil {
+		local, _ := req.Context().Value(http.LocalAddrContextKey).(net.Addr)
+		var client net.IP
+		if h, _, err := net.SplitHostPort(req.RemoteAddr); err == nil {
+			client = net.ParseIP(h)
+		}
+		if zones := s.Views.Select(s.ACL, local, client, ""); zones != nil {
+			sets = append(sets, zones)
+		}
+	}
+	sets = append(sets, s.zoneSets()...)
+
+	for _, zones := range sets {
+		if entry := zones.Get(domain); entry.Domain != "" && entry.Mail != nil && entry.Mail.MtaSts != nil {
+			return entry
+		}
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package dns
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/MarlikAlmighty/mdns/internal/config"
+	"github.com/MarlikAlmighty/mdns/internal/data"
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	"github.com/miekg/dns"
+)
+
+func TestDNS_ServeMTASTS(t *testing.T) {
+
+	d := data.New()
+	d.Set("example.com.", &models.DNSEntry{
+		Domain: "example.com.",
+		Ipv4s:  []string{"192.0.2.1"},
+		Mail:   &models.MailPolicy{MtaSts: &models.MtaStsPolicy{Mode: "enforce", Mx: []string{"mx1.example.com", "*.example.net"}}},
+	})
+	d.Set("example.org.", &models.DNSEntry{Domain: "example.org.", Ipv4s: []string{"192.0.2.2"}})
+	s := New(d, &config.Configuration{MtaStsAddresses: []string{"198.51.100.1", "2001:db8::1"}})
+
+	// zone of view has own policy
+	if err := s.Views.Set(&models.View{Name: "internal", Clients: []string{"10.0.0.0/8"}}); err != nil {
+		t.Fatal(err)
+	}
+	zones, _ := s.zonesOf("internal")
+	zones.Set("example.net.", &models.DNSEntry{
+		Domain: "example.net.",
+		Mail:   &models.MailPolicy{MtaSts: &models.MtaStsPolicy{Mode: "testing", Mx: []string{"mx.example.net"}}},
+	})
+
+	srv := httptest.NewTLSServer(s.WellKnown(http.NotFoundHandler()))
+	defer srv.Close()
+
+	// sni names policy host like sending mta does
+	get := func(url, host, sni, path string) (int, string) {
+		req, err := http.NewRequest(http.MethodGet, url+path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Host = host
+		tr := srv.Client().Transport.(*http.Transport).Clone()
+		tr.TLSClientConfig.ServerName, tr.TLSClientConfig.InsecureSkipVerify = sni, true
+		resp, err := (&http.Client{Transport: tr}).Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		b, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, string(b)
+	}
+
+	want := "version: STSv1\r\nmode: enforce\r\nmx: mx1.example.com\r\nmx: *.example.net\r\nmax_age: 604800\r\n"
+	if code, body := get(srv.URL, "mta-sts.example.com:443", "mta-sts.example.com", mtaSTSPath); code != http.StatusOK || body != want {
+		t.Errorf("policy = %v %q, want %q", code, body, want)
+	}
+	want = "version: STSv1\r\nmode: testing\r\nmx: mx.example.net\r\nmax_age: 604800\r\n"
+	if code, body := get(srv.URL, "mta-sts.example.net", "mta-sts.example.net", mtaSTSPath); code != http.StatusOK || body != want {
+		t.Errorf("policy of view = %v %q, want %q", code, body, want)
+	}
+	for _, v := range []struct{ host, sni, path string }{
+		{"mta-sts.example.org", "mta-sts.example.org", mtaSTSPath},
+		{"mta-sts.example.edu", "mta-sts.example.edu", mtaSTSPath},
+		{"example.com", "example.com", mtaSTSPath},
+		{"mta-sts.example.com", "mta-sts.example.com", "/"},
+		{"mta-sts.example.com", "example.com", mtaSTSPath},
+	} {
+		if code, _ := get(srv.URL, v.host, v.sni, v.path); code != http.StatusNotFound {
+			t.Errorf("%v%v with sni %v = %v, want 404", v.host, v.path, v.sni, code)
+		}
+	}
+
+	// no policy over plain http
+	plain := httptest.NewServer(s.WellKnown(http.NotFoundHandler()))
+	defer plain.Close()
+	if code, _ := get(plain.URL, "mta-sts.example.com", "", mtaSTSPath); code != http.StatusNotFound {
+		t.Errorf("policy over http = %v, want 404", code)
+	}
+
+	// policy host answers with addresses of mdns, id of record follows the policy
+	entry := d.Get("example.com.")
+	query := func(name string, qtype uint16) []dns.RR {
+		msg := &dns.Msg{}
+		msg.SetQuestion(name, qtype)
+		s.answer(msg, entry, nil, nil)
+		return msg.Answer
+	}
+	if rrs := query("mta-sts.example.com.", dns.TypeA); len(rrs) != 1 || rrs[0].(*dns.A).A.String() != "198.51.100.1" {
+		t.Errorf("A = %v", rrs)
+	}
+	if rrs := query("mta-sts.example.com.", dns.TypeAAAA); len(rrs) != 1 || rrs[0].(*dns.AAAA).AAAA.String() != "2001:db8::1" {
+		t.Errorf("AAAA = %v", rrs)
+	}
+	rrs := query("_mta-sts.example.com.", dns.TypeTXT)
+	if len(rrs) != 1 || !strings.HasPrefix(rrs[0].(*dns.TXT).Txt[0], "v=STSv1; id=") {
+		t.Fatalf("TXT = %v", rrs)
+	}
+	id := rrs[0].(*dns.TXT).Txt[0]
+	entry.Mail.MtaSts.Mode = "testing"
+	if rrs = query("_mta-sts.example.com.", dns.TypeTXT); rrs[0].(*dns.TXT).Txt[0] == id {
+		t.Errorf("id %v of changed policy is kept", id)
+	}
+}
@@ -0,0 +1,98 @@
+package dns
+
+import (
+	"errors"
+	"net"
+	"strings"
+)
+
+// modes of AAAA synthesis of zones
+const (
+	synthesisMapped = "mapped"
+	synthesisNAT64  = "nat64"
+)
+
+// defaultNAT64 well-known prefix of RFC 6052
+const defaultNAT64 = "64:ff9b::/96"
+
+var errNAT64Prefix = errors.New("nat64: prefix must be ipv6 of length 32, 40, 48, 56, 64 or 96")
+
+// ParseNAT64 parse prefix of nat64 synthesis, well-known prefix when empty
+func ParseNAT64(prefix string) (*net.IPNet, error) {
+
+	if strings.TrimSpace(prefix) == "" {
+		prefix = defaultNAT64
+	}
+
+	ip, network, err := net.ParseCIDR(strings.TrimSpace(prefix))
+	if err != nil || ip.To4() != nil {
+		return nil, errNAT64Prefix
+	}
+
+	switch ones, _ := network.Mask.Size(); ones {
+	case 32, 40, 48, 56, 64, 96:
+	default:
+		return nil, errNAT64Prefix
+	}
+
+	// bits 64 to 71 are reserved
+	if network.IP[8] != 0 {
+		return nil, errNAT64Prefix
+	}
+
+	return network, nil
+}
+
+// embed ipv4 address into nat64 prefix (RFC 6052 section 2.2), the octet of bits 64 to 71 is skipped
+func embed(prefix *net.IPNet, ip4 net.IP) net.IP {
+
+	ip4 = ip4.To4()
+	if ip4 == nil {
+		return nil
+	}
+
+	ip := make(net.IP, net.IPv6len)
+	copy(ip, prefix.IP.To16())
+
+	ones, _ := prefix.Mask.Size()
+	pos := ones / 8
+	for _, b := range ip4 {
+		if pos == 8 {
+			pos++
+		}
+		ip[pos] = b
+		pos++
+	}
+
+	return ip
+}
+
+// synthesize AAAA addresses of ipv4 addresses by mode of zone, nil without synthesis
+func synthesize(mode, prefix string, ipv4s []string) []string {
+
+	var network *net.IPNet
+	switch mode {
+	case synthesisMapped:
+	case synthesisNAT64:
+		var err error
+		if network, err = ParseNAT64(prefix); err != nil {
+			return nil
+		}
+	default:
+		return nil
+	}
+
+	var ipv6s []string
+	for _, v := range ipv4s {
+		ip4 := net.ParseIP(v).To4()
+		if ip4 == nil {
+			continue
+		}
+		if network == nil {
+			ipv6s = append(ipv6s, "::ffff:"+ip4.String())
+		} else {
+			ipv6s = append(ipv6s, embed(network, ip4).String())
+		}
+	}
+	return ipv6s
+}
@@ -0,0 +1,84 @@
+package dns
+
+import (
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	"github.com/miekg/dns"
+)
+
+func TestEmbed(t *testing.T) {
+
+	// examples of RFC 6052 section 2.4
+	tests := []struct {
+		prefix string
+		want   string
+	}{
+		{"2001:db8::/32", "2001:db8:c000:221::"},
+		{"2001:db8:100::/40", "2001:db8:1c0:2:21::"},
+		{"2001:db8:122::/48", "2001:db8:122:c000:2:2100::"},
+		{"2001:db8:122:300::/56", "2001:db8:122:3c0:0:221::"},
+		{"2001:db8:122:344::/64", "2001:db8:122:344:c0:2:2100:0"},
+		{"2001:db8:122:344::/96", "2001:db8:122:344::c000:221"},
+		{"", "64:ff9b::c000:221"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.prefix, func(t *testing.T) {
+			network, err := ParseNAT64(tt.prefix)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := embed(network, net.ParseIP("192.0.2.33")).String(); got != tt.want {
+				t.Errorf("embed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseNAT64(t *testing.T) {
+	for _, v := range []string{"2001:db8::/33", "192.0.2.0/24", "2001:db8:0:0:100::/96", "64:ff9b::"} {
+		if _, err := ParseNAT64(v); err == nil {
+			t.Errorf("ParseNAT64(%q) without error", v)
+		}
+	}
+}
+
+func TestSynthesis(t *testing.T) {
+
+	s := &DNS{}
+	entry := &models.DNSEntry{Domain: "example.com.", Ipv4s: []string{"192.0.2.1"}}
+	header := dns.RR_Header{Name: "example.com.", Rrtype: dns.TypeAAAA, Class: dns.ClassINET}
+
+	aaaa := func() []string {
+		msg := &dns.Msg{}
+		s.aaaa(msg, entry, header, nil)
+		var got []string
+		for _, rr := range msg.Answer {
+			got = append(got, rr.(*dns.AAAA).AAAA.String())
+		}
+		return got
+	}
+
+	if got := aaaa(); got != nil {
+		t.Errorf("without synthesis got %v", got)
+	}
+
+	// net.IP prints mapped addresses as ipv4
+	entry.IPV6Synthesis = synthesisMapped
+	if got, want := aaaa(), []string{"192.0.2.1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("mapped got %v, want %v", got, want)
+	}
+
+	entry.IPV6Synthesis, entry.Nat64Prefix = synthesisNAT64, "2001:db8:64::/96"
+	if got, want := aaaa(), []string{"2001:db8:64::c000:201"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("nat64 got %v, want %v", got, want)
+	}
+
+	// explicit addresses win
+	entry.Ipv6s = []string{"2001:db8::1"}
+	if got, want := aaaa(), []string{"2001:db8::1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("explicit got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,155 @@
+package dns
+
+import (
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/MarlikAlmighty/mdns/internal/data"
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	"github.com/miekg/dns"
+)
+
+// suffixes of reverse zones
+const (
+	arpaIPv4 = "in-addr.arpa."
+	arpaIPv6 = "ip6.arpa."
+)
+
+// isReverse name lies in reverse tree of ipv4 or ipv6
+func isReverse(name string) bool {
+	return dns.IsSubDomain(arpaIPv4, name) || dns.IsSubDomain(arpaIPv6, name)
+}
+
+// reverseAddr address of reverse name like 1.2.0.192.in-addr.arpa. or nibbles of ip6.arpa.,
+// nil for names which are no complete address
+func reverseAddr(name string) net.IP {
+
+	name = strings.ToLower(dns.Fqdn(name))
+
+	switch {
+	case strings.HasSuffix(name, "."+arpaIPv4):
+		labels := dns.SplitDomainName(strings.TrimSuffix(name, "."+arpaIPv4))
+		if len(labels) != net.IPv4len {
+			return nil
+		}
+		ip := make(net.IP, net.IPv4len)
+		for i, l := range labels {
+			n, err := strconv.ParseUint(l, 10, 8)
+			if err != nil || (len(l) > 1 && l[0] == '0') {
+				return nil
+			}
+			ip[net.IPv4len-1-i] = byte(n)
+		}
+		return ip
+
+	case strings.HasSuffix(name, "."+arpaIPv6):
+		labels := dns.SplitDomainName(strings.TrimSuffix(name, "."+arpaIPv6))
+		if len(labels) != 2*net.IPv6len {
+			return nil
+		}
+		ip := make(net.IP, net.IPv6len)
+		for i, l := range labels {
+			n, err := strconv.ParseUint(l, 16, 4)
+			if err != nil || len(l) != 1 {
+				return nil
+			}
+			pos := 2*net.IPv6len - 1 - i
+			if pos%2 == 0 {
+				ip[pos/2] |= byte(n) << 4
+			} else {
+				ip[pos/2] |= byte(n)
+			}
+		}
+		return ip
+	}
+
+	return nil
+}
+
+// owner absolute owner name of record of zone, relative names lie below zone, @ is zone itself
+func owner(zone, name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "@" {
+		return strings.ToLower(zone)
+	}
+	if dns.IsFqdn(name) {
+		return name
+	}
+	return name + "." + strings.ToLower(zone)
+}
+
+// reverse answer query of reverse zone: manual records, then names of entries with queried address
+// when zone generates PTRs, otherwise the name does not exist
+func (s *DNS) reverse(msg *dns.Msg, entry *models.DNSEntry, zones *data.ResolvedData) {
+
+	q := msg.Question[0]
+	name := strings.ToLower(q.Name)
+	header := dns.RR_Header{Name: q.Name, Rrtype: dns.TypePTR, Class: dns.ClassINET, Ttl: 600}
+
+	if name == strings.ToLower(entry.Domain) {
+		switch q.Qtype {
+		case dns.TypeSOA:
+			s.soa(msg, entry)
+		case dns.TypeNS:
+			s.ns(msg, entry)
+		default:
+			s.nodata(msg, entry)
+		}
+		return
+	}
+
+	found := false
+	for _, v := range entry.Ptrs {
+		if v == nil || owner(entry.Domain, v.Name) != name {
+			continue
+		}
+		found = true
+		switch {
+		case v.Cname != "":
+			// classless delegation, the record lives in zone of child
+			msg.Answer = append(msg.Answer, &dns.CNAME{
+				Hdr:    dns.RR_Header{Name: q.Name, Rrtype: dns.TypeCNAME, Class: dns.ClassINET, Ttl: 600},
+				Target: dns.Fqdn(v.Cname),
+			})
+			return
+		case q.Qtype == dns.TypePTR && v.Ptr != "":
+			msg.Answer = append(msg.Answer, &dns.PTR{Hdr: header, Ptr: dns.Fqdn(v.Ptr)})
+		}
+	}
+
+	if !found && entry.AutoPtr && zones != nil {
+		if ip := reverseAddr(name); ip != nil {
+			for _, host := range hostsOf(zones, ip) {
+				found = true
+				if q.Qtype == dns.TypePTR {
+					msg.Answer = append(msg.Answer, &dns.PTR{Hdr: header, Ptr: host})
+				}
+			}
+		}
+	}
+
+	switch {
+	case !found:
+		s.nodata(msg, entry)
+		msg.Rcode = dns.RcodeNameError
+	case len(msg.Answer) == 0:
+		s.nodata(msg, entry)
+	}
+}
+
+// hostsOf names of entries which answer with address, sorted
+func hostsOf(zones *data.ResolvedData, ip net.IP) []string {
+	var hosts []string
+	for _, v := range zones.GetMap() {
+		for _, a := range append(append([]string{}, v.Ipv4s...), v.Ipv6s...) {
+			if ip.Equal(net.ParseIP(a)) {
+				hosts = append(hosts, dns.Fqdn(v.Domain))
+				break
+			}
+		}
+	}
+	sort.Strings(hosts)
+	return hosts
+}
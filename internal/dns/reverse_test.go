@@ -0,0 +1,121 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/MarlikAlmighty/mdns/internal/data"
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	"github.com/miekg/dns"
+)
+
+func TestReverseAddr(t *testing.T) {
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"1.2.0.192.in-addr.arpa.", "192.0.2.1"},
+		{"1.2.0.192.IN-ADDR.ARPA", "192.0.2.1"},
+		{"2.0.192.in-addr.arpa.", ""},
+		{"256.2.0.192.in-addr.arpa.", ""},
+		{"01.2.0.192.in-addr.arpa.", ""},
+		{"1.0-25.2.0.192.in-addr.arpa.", ""},
+		{"1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa.", "2001:db8::1"},
+		{"b.a.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa.", "2001:db8::ab"},
+		{"8.b.d.0.1.0.0.2.ip6.arpa.", ""},
+		{"example.com.", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ""
+			if ip := reverseAddr(tt.name); ip != nil {
+				got = ip.String()
+			}
+			if got != tt.want {
+				t.Errorf("reverseAddr() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDNS_reverse(t *testing.T) {
+
+	zones := data.New()
+	zones.Set("example.com.", &models.DNSEntry{Domain: "example.com.", Ipv4s: []string{"192.0.2.1"}})
+	zones.Set("www.example.com.", &models.DNSEntry{Domain: "www.example.com.", Ipv4s: []string{"192.0.2.1"}})
+	zones.Set("v6.example.com.", &models.DNSEntry{Domain: "v6.example.com.", Ipv6s: []string{"2001:db8::1"}})
+
+	parent := &models.DNSEntry{
+		Domain:  "2.0.192.in-addr.arpa.",
+		AutoPtr: true,
+		Ptrs: []*models.PtrRecord{
+			{Name: "5", Ptr: "mail.example.com"},
+			{Name: "130", Cname: "130.128-25.2.0.192.in-addr.arpa."},
+		},
+	}
+	child := &models.DNSEntry{
+		Domain: "128-25.2.0.192.in-addr.arpa.",
+		Ptrs:   []*models.PtrRecord{{Name: "130.128-25.2.0.192.in-addr.arpa.", Ptr: "customer.example.net."}},
+	}
+	v6 := &models.DNSEntry{Domain: "8.b.d.0.1.0.0.2.ip6.arpa.", AutoPtr: true}
+
+	s := &DNS{}
+
+	tests := []struct {
+		name   string
+		entry  *models.DNSEntry
+		qname  string
+		qtype  uint16
+		rcode  int
+		answer []string
+	}{
+		{"auto_ptr", parent, "1.2.0.192.in-addr.arpa.", dns.TypePTR, dns.RcodeSuccess, []string{"example.com.", "www.example.com."}},
+		{"manual_ptr", parent, "5.2.0.192.in-addr.arpa.", dns.TypePTR, dns.RcodeSuccess, []string{"mail.example.com."}},
+		{"classless_cname", parent, "130.2.0.192.in-addr.arpa.", dns.TypePTR, dns.RcodeSuccess, []string{"130.128-25.2.0.192.in-addr.arpa."}},
+		{"classless_child", child, "130.128-25.2.0.192.in-addr.arpa.", dns.TypePTR, dns.RcodeSuccess, []string{"customer.example.net."}},
+		{"unknown_address", parent, "9.2.0.192.in-addr.arpa.", dns.TypePTR, dns.RcodeNameError, nil},
+		{"other_type", parent, "1.2.0.192.in-addr.arpa.", dns.TypeA, dns.RcodeSuccess, nil},
+		{"apex_soa", parent, "2.0.192.in-addr.arpa.", dns.TypeSOA, dns.RcodeSuccess, []string{"2.0.192.in-addr.arpa."}},
+		{"nibbles", v6, "1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa.", dns.TypePTR, dns.RcodeSuccess, []string{"v6.example.com."}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := &dns.Msg{}
+			msg.SetQuestion(tt.qname, tt.qtype)
+			s.answer(msg, tt.entry, nil, zones)
+			if msg.Rcode != tt.rcode {
+				t.Errorf("rcode = %v, want %v", dns.RcodeToString[msg.Rcode], dns.RcodeToString[tt.rcode])
+			}
+			var got []string
+			for _, rr := range msg.Answer {
+				switch v := rr.(type) {
+				case *dns.PTR:
+					got = append(got, v.Ptr)
+				case *dns.CNAME:
+					got = append(got, v.Target)
+				case *dns.SOA:
+					got = append(got, v.Hdr.Name)
+				}
+			}
+			if len(got) != len(tt.answer) {
+				t.Fatalf("answer = %v, want %v", got, tt.answer)
+			}
+			for i := range got {
+				if got[i] != tt.answer[i] {
+					t.Errorf("answer = %v, want %v", got, tt.answer)
+				}
+			}
+			if len(msg.Answer) == 0 && len(msg.Ns) == 0 {
+				t.Errorf("negative answer without SOA")
+			}
+		})
+	}
+
+	// forward zone without addresses
+	msg := &dns.Msg{}
+	msg.SetQuestion("example.org.", dns.TypePTR)
+	s.answer(msg, &models.DNSEntry{Domain: "example.org."}, nil, zones)
+	if len(msg.Answer) != 0 || len(msg.Ns) != 1 {
+		t.Errorf("forward zone PTR = %v", msg)
+	}
+}
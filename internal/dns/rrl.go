@@ -0,0 +1,335 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	"github.com/miekg/dns"
+)
+
+// classes of responses, each class has own buckets
+const (
+	rrlResponse = "response"
+	rrlNodata   = "nodata"
+	rrlNxdomain = "nxdomain"
+	rrlReferral = "referral"
+	rrlError    = "error"
+)
+
+// actions of rate limiting
+const (
+	rrlSend = iota
+	rrlDrop
+	rrlSlip
+)
+
+// rrlBuckets limit of buckets, idle and then longest unused buckets are removed when it is reached,
+// so floods from many client prefixes do not grow memory without bound
+const rrlBuckets = 100000
+
+var errRRLSettings = errors.New("rrl: rates, window, slip and prefix lengths must not be negative, prefix lengths at most 32 and 128")
+
+// bucket balance of responses for one client prefix, class and name
+type bucket struct {
+	balance float64
+	last    time.Time
+	limited int64
+}
+
+// RRL response rate limiting of udp answers against amplification
+type RRL struct {
+	settings models.Rrl
+	buckets  map[string]*bucket
+	size     int
+	now      func() time.Time
+	// counters of all checked, dropped, truncated and exempted responses
+	responses atomic.Int64
+	dropped   atomic.Int64
+	slipped   atomic.Int64
+	exempted  atomic.Int64
+	mux       sync.Mutex
+}
+
+// NewRRL simple constructor, zero responses per second disables limiting
+func NewRRL(md *models.Rrl) (*RRL, error) {
+	l := &RRL{
+		buckets: make(map[string]*bucket),
+		size:    rrlBuckets,
+		now:     time.Now,
+	}
+	if err := l.Set(md); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Set validate and replace settings, buckets are kept
+func (l *RRL) Set(md *models.Rrl) error {
+
+	if err := validRRL(md); err != nil {
+		return err
+	}
+
+	settings := models.Rrl{
+		ResponsesPerSecond: md.ResponsesPerSecond,
+		NxdomainsPerSecond: md.NxdomainsPerSecond,
+		ErrorsPerSecond:    md.ErrorsPerSecond,
+		Window:             md.Window,
+		Slip:               md.Slip,
+		IPV4PrefixLength:   md.IPV4PrefixLength,
+		IPV6PrefixLength:   md.IPV6PrefixLength,
+		Exempt:             md.Exempt,
+	}
+
+	l.mux.Lock()
+	l.settings = settings
+	l.mux.Unlock()
+	return nil
+}
+
+// Update validate and change given settings, omitted settings and buckets are kept
+func (l *RRL) Update(md *models.RrlUpdate) error {
+
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	settings := l.settings
+	for _, v := range []struct {
+		from *int64
+		to   *int64
+	}{
+		{md.ResponsesPerSecond, &settings.ResponsesPerSecond},
+		{md.NxdomainsPerSecond, &settings.NxdomainsPerSecond},
+		{md.ErrorsPerSecond, &settings.ErrorsPerSecond},
+		{md.Window, &settings.Window},
+		{md.Slip, &settings.Slip},
+		{md.IPV4PrefixLength, &settings.IPV4PrefixLength},
+		{md.IPV6PrefixLength, &settings.IPV6PrefixLength},
+	} {
+		if v.from != nil {
+			*v.to = *v.from
+		}
+	}
+	if md.Exempt != nil {
+		settings.Exempt = md.Exempt
+	}
+
+	if err := validRRL(&settings); err != nil {
+		return err
+	}
+	l.settings = settings
+	return nil
+}
+
+// validRRL check ranges of settings
+func validRRL(md *models.Rrl) error {
+	if md.ResponsesPerSecond < 0 || md.NxdomainsPerSecond < 0 || md.ErrorsPerSecond < 0 ||
+		md.Window < 0 || md.Slip < 0 ||
+		md.IPV4PrefixLength < 0 || md.IPV4PrefixLength > 32 ||
+		md.IPV6PrefixLength < 0 || md.IPV6PrefixLength > 128 {
+		return errRRLSettings
+	}
+	return nil
+}
+
+// Get settings with counters
+func (l *RRL) Get() *models.Rrl {
+	l.mux.Lock()
+	md := l.settings
+	l.mux.Unlock()
+	md.Responses = l.responses.Load()
+	md.Dropped = l.dropped.Load()
+	md.Slipped = l.slipped.Load()
+	md.Exempted = l.exempted.Load()
+	return &md
+}
+
+// Limit decide whether response to client is sent, dropped or sent truncated,
+// exempt gets access lists of exempted clients and is called without lock
+func (l *RRL) Limit(ip net.IP, msg *dns.Msg, exempt func(groups []string) bool) int {
+
+	l.mux.Lock()
+	enabled, groups := l.settings.ResponsesPerSecond > 0, l.settings.Exempt
+	l.mux.Unlock()
+
+	if !enabled || ip == nil {
+		return rrlSend
+	}
+
+	l.responses.Add(1)
+
+	if exempt(groups) {
+		l.exempted.Add(1)
+		return rrlSend
+	}
+
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	// settings may be disabled in between
+	if l.settings.ResponsesPerSecond == 0 {
+		return rrlSend
+	}
+
+	class, name := classify(msg)
+
+	rate := l.settings.ResponsesPerSecond
+	switch class {
+	case rrlNxdomain:
+		if l.settings.NxdomainsPerSecond > 0 {
+			rate = l.settings.NxdomainsPerSecond
+		}
+	case rrlError:
+		if l.settings.ErrorsPerSecond > 0 {
+			rate = l.settings.ErrorsPerSecond
+		}
+	}
+
+	key := l.prefix(ip) + "/" + class + "/" + name
+	now := l.now()
+
+	b, ok := l.buckets[key]
+	if !ok {
+		if len(l.buckets) >= l.size {
+			l.evict(now)
+		}
+		b = &bucket{balance: float64(rate), last: now}
+		l.buckets[key] = b
+	}
+
+	// balance is refilled with rate per second, but not over one second of responses,
+	// and debt is limited to window, so clients are free again after window without queries
+	b.balance += now.Sub(b.last).Seconds() * float64(rate)
+	if b.balance > float64(rate) {
+		b.balance = float64(rate)
+	}
+	if debt := -float64(rate * l.settings.Window); b.balance < debt {
+		b.balance = debt
+	}
+	b.last = now
+	b.balance--
+
+	if b.balance >= 0 {
+		return rrlSend
+	}
+
+	b.limited++
+	if l.settings.Slip > 0 && b.limited%l.settings.Slip == 0 {
+		l.slipped.Add(1)
+		return rrlSlip
+	}
+
+	l.dropped.Add(1)
+	return rrlDrop
+}
+
+// Clean remove buckets without responses during window until context is done
+func (l *RRL) Clean(ctx context.Context, interval time.Duration) {
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			l.mux.Lock()
+			l.prune(l.now())
+			l.mux.Unlock()
+		}
+	}
+}
+
+// prune remove buckets without responses during window, caller holds lock
+func (l *RRL) prune(now time.Time) {
+	window := time.Duration(l.settings.Window+1) * time.Second
+	for k, b := range l.buckets {
+		if now.Sub(b.last) > window {
+			delete(l.buckets, k)
+		}
+	}
+}
+
+// evict make room for new bucket: idle buckets are removed, then the longest unused tenth
+// of the limit, so full maps are not sorted again for every new client, caller holds lock
+func (l *RRL) evict(now time.Time) {
+
+	l.prune(now)
+	if len(l.buckets) < l.size {
+		return
+	}
+
+	keys := make([]string, 0, len(l.buckets))
+	for k := range l.buckets {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return l.buckets[keys[i]].last.Before(l.buckets[keys[j]].last)
+	})
+
+	n := len(keys) - l.size + 1 + l.size/10
+	if n > len(keys) {
+		n = len(keys)
+	}
+	for _, k := range keys[:n] {
+		delete(l.buckets, k)
+	}
+}
+
+// prefix network of client with configured prefix length, caller holds lock
+func (l *RRL) prefix(ip net.IP) string {
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4.Mask(net.CIDRMask(int(l.settings.IPV4PrefixLength), 32)).String()
+	}
+	return ip.Mask(net.CIDRMask(int(l.settings.IPV6PrefixLength), 128)).String()
+}
+
+// classify class of response and name of bucket, negative answers are counted per zone
+// so random names do not escape limits
+func classify(msg *dns.Msg) (string, string) {
+
+	name := ""
+	if len(msg.Question) > 0 {
+		name = strings.ToLower(msg.Question[0].Name)
+	}
+
+	zone := name
+	soa, ns := false, false
+	for _, rr := range msg.Ns {
+		switch rr.Header().Rrtype {
+		case dns.TypeSOA:
+			zone, soa = strings.ToLower(rr.Header().Name), true
+		case dns.TypeNS:
+			ns = true
+		}
+	}
+
+	switch {
+	case msg.Rcode == dns.RcodeNameError:
+		return rrlNxdomain, zone
+	case msg.Rcode != dns.RcodeSuccess:
+		return rrlError, ""
+	case len(msg.Answer) > 0:
+		return rrlResponse, name
+	case ns && !soa:
+		return rrlReferral, name
+	default:
+		return rrlNodata, zone
+	}
+}
+
+// slip truncated empty reply, client retries over tcp
+func slip(r *dns.Msg) *dns.Msg {
+	msg := &dns.Msg{}
+	msg.SetReply(r)
+	msg.Truncated = true
+	return msg
+}
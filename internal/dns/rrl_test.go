@@ -0,0 +1,198 @@
+package dns
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	"github.com/miekg/dns"
+)
+
+func TestRRL_Limit(t *testing.T) {
+
+	l, err := NewRRL(&models.Rrl{
+		ResponsesPerSecond: 2,
+		NxdomainsPerSecond: 1,
+		Window:             5,
+		Slip:               2,
+		IPV4PrefixLength:   24,
+		IPV6PrefixLength:   56,
+		Exempt:             []string{"monitoring"},
+	})
+	if err != nil {
+		t.Fatalf("NewRRL() error = %v", err)
+	}
+
+	now := time.Unix(1700000000, 0)
+	l.now = func() time.Time { return now }
+
+	answer := func(name string) *dns.Msg {
+		r := &dns.Msg{}
+		r.SetQuestion(name, dns.TypeA)
+		msg := &dns.Msg{}
+		msg.SetReply(r)
+		msg.Answer = append(msg.Answer, &dns.A{
+			Hdr: dns.RR_Header{Name: name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60},
+			A:   net.ParseIP("192.0.2.1"),
+		})
+		return msg
+	}
+
+	nxdomain := func(name string) *dns.Msg {
+		r := &dns.Msg{}
+		r.SetQuestion(name, dns.TypeA)
+		msg := &dns.Msg{}
+		msg.SetRcode(r, dns.RcodeNameError)
+		msg.Ns = append(msg.Ns, &dns.SOA{
+			Hdr: dns.RR_Header{Name: "example.com.", Rrtype: dns.TypeSOA, Class: dns.ClassINET, Ttl: 60},
+		})
+		return msg
+	}
+
+	notExempt := func([]string) bool { return false }
+	client := net.ParseIP("198.51.100.10")
+	neighbour := net.ParseIP("198.51.100.20")
+
+	tests := []struct {
+		name   string
+		ip     net.IP
+		msg    *dns.Msg
+		exempt func([]string) bool
+		want   int
+	}{
+		{"first", client, answer("example.com."), notExempt, rrlSend},
+		{"second", client, answer("example.com."), notExempt, rrlSend},
+		{"over_limit_dropped", neighbour, answer("example.com."), notExempt, rrlDrop},
+		{"over_limit_slipped", client, answer("example.com."), notExempt, rrlSlip},
+		{"other_name", client, answer("www.example.com."), notExempt, rrlSend},
+		{"other_prefix", net.ParseIP("198.51.101.10"), answer("example.com."), notExempt, rrlSend},
+		{"exempted", client, answer("example.com."), func([]string) bool { return true }, rrlSend},
+		{"nxdomain_first", client, nxdomain("a.example.com."), notExempt, rrlSend},
+		{"nxdomain_random_name", client, nxdomain("b.example.com."), notExempt, rrlDrop},
+		{"ipv6_prefix", net.ParseIP("2001:db8:0:1::1"), answer("example.com."), notExempt, rrlSend},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := l.Limit(tt.ip, tt.msg, tt.exempt); got != tt.want {
+				t.Errorf("Limit() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	md := l.Get()
+	if md.Responses != int64(len(tests)) || md.Dropped != 2 || md.Slipped != 1 || md.Exempted != 1 {
+		t.Errorf("counters = %+v", md)
+	}
+
+	// debt of limited responses is paid back with rate per second
+	now = now.Add(2 * time.Second)
+	if got := l.Limit(client, answer("example.com."), notExempt); got != rrlSend {
+		t.Errorf("Limit() after two seconds = %v, want %v", got, rrlSend)
+	}
+
+	// zero rate disables limiting
+	if err = l.Set(&models.Rrl{}); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	for i := 0; i < 10; i++ {
+		if got := l.Limit(client, answer("example.com."), notExempt); got != rrlSend {
+			t.Fatalf("Limit() disabled = %v, want %v", got, rrlSend)
+		}
+	}
+
+	if err = l.Set(&models.Rrl{ResponsesPerSecond: 1, IPV4PrefixLength: 33}); err == nil {
+		t.Errorf("Set() with prefix length 33 got no error")
+	}
+}
+
+func TestRRL_Update(t *testing.T) {
+
+	l, err := NewRRL(&models.Rrl{
+		ResponsesPerSecond: 2,
+		Window:             5,
+		Slip:               2,
+		IPV4PrefixLength:   24,
+		IPV6PrefixLength:   56,
+		Exempt:             []string{"monitoring"},
+	})
+	if err != nil {
+		t.Fatalf("NewRRL() error = %v", err)
+	}
+
+	// omitted settings are kept
+	rate := int64(10)
+	if err = l.Update(&models.RrlUpdate{ResponsesPerSecond: &rate}); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	md := l.Get()
+	if md.ResponsesPerSecond != 10 || md.Window != 5 || md.Slip != 2 || md.IPV4PrefixLength != 24 ||
+		md.IPV6PrefixLength != 56 || len(md.Exempt) != 1 {
+		t.Errorf("settings after Update() = %+v", md)
+	}
+
+	// invalid settings change nothing
+	prefix := int64(33)
+	if err = l.Update(&models.RrlUpdate{ResponsesPerSecond: new(int64), IPV4PrefixLength: &prefix}); err == nil {
+		t.Error("Update() with prefix length 33 got no error")
+	}
+	if md = l.Get(); md.ResponsesPerSecond != 10 || md.IPV4PrefixLength != 24 {
+		t.Errorf("settings after failed Update() = %+v", md)
+	}
+
+	// access lists of exempted clients are checked without lock
+	r := &dns.Msg{}
+	r.SetQuestion("example.com.", dns.TypeA)
+	exempt := func(groups []string) bool {
+		return len(l.Get().Exempt) == len(groups)
+	}
+	if got := l.Limit(net.ParseIP("192.0.2.1"), r, exempt); got != rrlSend {
+		t.Errorf("Limit() of exempted client = %v, want %v", got, rrlSend)
+	}
+	if md = l.Get(); md.Exempted != 1 {
+		t.Errorf("counters = %+v", md)
+	}
+
+	// exempted clients refer to known access lists
+	s := &DNS{ACL: NewACL(), RRL: l}
+	if err = s.SetRRL(&models.RrlUpdate{Exempt: []string{"missing"}}); err == nil {
+		t.Error("SetRRL() with unknown access list got no error")
+	}
+	if err = s.SetRRL(&models.RrlUpdate{Exempt: []string{"localhost"}}); err != nil {
+		t.Errorf("SetRRL() error = %v", err)
+	}
+	if md = l.Get(); len(md.Exempt) != 1 || md.Exempt[0] != "localhost" {
+		t.Errorf("exempted after SetRRL() = %v", md.Exempt)
+	}
+}
+
+func TestRRL_Buckets(t *testing.T) {
+
+	l, err := NewRRL(&models.Rrl{ResponsesPerSecond: 5, Window: 5, IPV4PrefixLength: 24})
+	if err != nil {
+		t.Fatalf("NewRRL() error = %v", err)
+	}
+	l.size = 10
+
+	now := time.Unix(1700000000, 0)
+	l.now = func() time.Time { return now }
+
+	r := &dns.Msg{}
+	r.SetQuestion("example.com.", dns.TypeA)
+	notExempt := func([]string) bool { return false }
+
+	// floods of prefixes within window evict the longest unused buckets
+	for i := 0; i < 25; i++ {
+		now = now.Add(time.Millisecond)
+		l.Limit(net.IPv4(10, 0, byte(i), 1), r, notExempt)
+		if len(l.buckets) > l.size {
+			t.Fatalf("buckets after %v clients = %v, want at most %v", i+1, len(l.buckets), l.size)
+		}
+	}
+	if _, ok := l.buckets["10.0.24.0/nodata/example.com."]; !ok {
+		t.Error("bucket of last client is evicted")
+	}
+	if _, ok := l.buckets["10.0.0.0/nodata/example.com."]; ok {
+		t.Error("bucket of first client is kept")
+	}
+}
@@ -0,0 +1,124 @@
+package dns
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	"github.com/miekg/dns"
+)
+
+// transfer send all records of zone over tcp, soa goes first and last
+func (s *DNS) transfer(w dns.ResponseWriter, r *dns.Msg, entry *models.DNSEntry) error {
+
+	if w.LocalAddr().Network() != "tcp" {
+		msg := &dns.Msg{}
+		msg.SetRcode(r, dns.RcodeRefused)
+		s.write(w, r, msg)
+		return errors.New("zone transfer over udp")
+	}
+
+	type query struct {
+		name  string
+		qtype uint16
+	}
+
+	queries := []query{
+		{entry.Domain, dns.TypeNS},
+		{entry.Domain, dns.TypeA},
+		{entry.Domain, dns.TypeAAAA},
+		{entry.Domain, dns.TypeMX},
+		{entry.Domain, dns.TypeCAA},
+		{entry.Domain, dns.TypeTXT},
+		{"_dmarc." + entry.Domain, dns.TypeTXT},
+	}
+	if len(entry.DkimKeys) == 0 && entry.DkimPublicKey != "" {
+		queries = append(queries, query{DkimSelector + "._domainkey." + entry.Domain, dns.TypeTXT})
+	}
+	for _, v := range s.DKIM.published(entry, "") {
+		queries = append(queries, query{v.Selector + "._domainkey." + entry.Domain, dns.TypeTXT})
+	}
+	if len(entry.Acme) > 0 {
+		queries = append(queries, query{"_acme-challenge." + entry.Domain, dns.TypeTXT})
+	}
+	if mail := entry.Mail; mail != nil {
+		if mail.MtaSts != nil {
+			queries = append(queries,
+				query{"_mta-sts." + entry.Domain, dns.TypeTXT},
+				query{mtaSTSLabel + entry.Domain, dns.TypeA},
+				query{mtaSTSLabel + entry.Domain, dns.TypeAAAA})
+		}
+		if mail.TLSRpt != nil {
+			queries = append(queries, query{"_smtp._tls." + entry.Domain, dns.TypeTXT})
+		}
+		if mail.Bimi != nil {
+			queries = append(queries, query{"default._bimi." + entry.Domain, dns.TypeTXT})
+		}
+	}
+	txts := make(map[string]bool)
+	for _, v := range queries {
+		txts[strings.ToLower(v.name)] = true
+	}
+	for _, v := range entry.Txts {
+		if v == nil {
+			continue
+		}
+		if name := owner(entry.Domain, v.Name); !txts[name] {
+			txts[name] = true
+			queries = append(queries, query{name, dns.TypeTXT})
+		}
+	}
+	srvs := make(map[string]bool)
+	for _, v := range entry.Srvs {
+		if v == nil {
+			continue
+		}
+		if name := owner(entry.Domain, v.Name); !srvs[name] {
+			srvs[name] = true
+			queries = append(queries, query{name, dns.TypeSRV})
+		}
+	}
+	if isReverse(entry.Domain) {
+		// reverse zones hold only their manual records
+		queries = queries[:1]
+		for _, v := range entry.Ptrs {
+			if v != nil {
+				queries = append(queries, query{owner(entry.Domain, v.Name), dns.TypePTR})
+			}
+		}
+	}
+
+	var rrs []dns.RR
+
+	soa := s.records(entry, entry.Domain, dns.TypeSOA)
+	rrs = append(rrs, soa...)
+	for _, v := range queries {
+		rrs = append(rrs, s.records(entry, v.name, v.qtype)...)
+	}
+
+	// addresses of own name servers, cuts to child zones with their glue
+	rrs = append(rrs, glue(entry.Domain, nameServers(entry))...)
+	for _, v := range entry.Delegations {
+		if v != nil && v.Name != "" {
+			m := &dns.Msg{}
+			s.referral(m, entry, v, owner(entry.Domain, v.Name))
+			rrs = append(append(rrs, m.Ns...), m.Extra...)
+		}
+	}
+	rrs = append(rrs, soa...)
+
+	ch := make(chan *dns.Envelope, 1)
+	ch <- &dns.Envelope{RR: rrs}
+	close(ch)
+
+	tr := &dns.Transfer{}
+	return tr.Out(w, r, ch)
+}
+
+// records build answer of own zone for name and type
+func (s *DNS) records(entry *models.DNSEntry, name string, qtype uint16) []dns.RR {
+	msg := &dns.Msg{}
+	msg.SetQuestion(name, qtype)
+	s.answer(msg, entry, nil, nil)
+	return msg.Answer
+}
@@ -0,0 +1,86 @@
+package dns
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+	"github.com/miekg/dns"
+)
+
+func TestChunks(t *testing.T) {
+	tests := []struct {
+		len  int
+		want []int
+	}{
+		{0, []int{0}},
+		{10, []int{10}},
+		{255, []int{255}},
+		{256, []int{255, 1}},
+		{600, []int{255, 255, 90}},
+	}
+	for _, tt := range tests {
+		got := chunks(strings.Repeat("a", tt.len))
+		if len(got) != len(tt.want) {
+			t.Fatalf("chunks(%v) = %v strings, want %v", tt.len, len(got), len(tt.want))
+		}
+		for i, v := range got {
+			if len(v) != tt.want[i] {
+				t.Errorf("chunks(%v)[%v] = %v bytes, want %v", tt.len, i, len(v), tt.want[i])
+			}
+		}
+	}
+}
+
+func TestTXT(t *testing.T) {
+
+	s := &DNS{}
+	entry := &models.DNSEntry{
+		Domain:        "example.com.",
+		Ipv4s:         []string{"192.0.2.1"},
+		DkimPublicKey: "short",
+		Txts: []*models.TxtRecord{
+			{Name: "_github-challenge", Values: []string{"abc"}},
+			{Name: "@", Values: []string{"google-site-verification=xyz"}},
+			{Name: "_dmarc", Values: []string{"v=DMARC1; p=reject"}},
+			{Name: "long.example.com.", Values: []string{strings.Repeat("x", 300)}},
+		},
+	}
+
+	query := func(name string) []string {
+		msg := &dns.Msg{}
+		msg.SetQuestion(name, dns.TypeTXT)
+		s.answer(msg, entry, nil, nil)
+		var got []string
+		for _, rr := range msg.Answer {
+			v := rr.(*dns.TXT)
+			if v.Hdr.Name != name {
+				t.Errorf("owner %v, want %v", v.Hdr.Name, name)
+			}
+			got = append(got, strings.Join(v.Txt, "|"))
+		}
+		return got
+	}
+
+	tests := []struct {
+		name string
+		want []string
+	}{
+		{"mail._domainkey.example.com.", []string{"v=DKIM1; k=rsa; p=short"}},
+		{"selector2._domainkey.example.com.", nil},
+		{"mail.example.com.", nil},
+		{"foo.example.com.", nil},
+		{"_github-challenge.example.com.", []string{"abc"}},
+		{"_dmarc.example.com.", []string{"v=DMARC1; p=reject"}},
+		{"example.com.", []string{"google-site-verification=xyz", "v=spf1 ip4:192.0.2.1 a mx ~all"}},
+		{"long.example.com.", []string{strings.Repeat("x", 255) + "|" + strings.Repeat("x", 45)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := query(tt.name)
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("TXT %v = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
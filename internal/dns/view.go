@@ -0,0 +1,260 @@
+package dns
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/MarlikAlmighty/mdns/internal/data"
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+const listenerPrefix = "listener:"
+
+var (
+	errViewName     = errors.New("view: empty name")
+	errViewUnknown  = errors.New("view: view does not exist")
+	errViewListener = errors.New("view: listener is not a listen address")
+)
+
+// listener local address of listener, port is empty for any port
+type listener struct {
+	ip   net.IP
+	port string
+}
+
+// view clients and listeners of view with own zones
+type view struct {
+	md        models.View
+	clients   []element
+	listeners []listener
+	zones     *data.ResolvedData
+}
+
+// Views named split horizon views, each with own zones
+type Views struct {
+	views  map[string]*view
+	sorted []*view
+	bound  []listener
+	mux    sync.RWMutex
+}
+
+// NewViews simple constructor
+func NewViews() *Views {
+	return &Views{
+		views: make(map[string]*view),
+	}
+}
+
+// ParseView parse definition like "name=10.0.0.0/8 key:office. listener:10.0.0.1:53"
+func ParseView(def string) (*models.View, error) {
+	kv := strings.SplitN(def, "=", 2)
+	if len(kv) != 2 {
+		return nil, fmt.Errorf("view: invalid definition %q", def)
+	}
+	md := &models.View{Name: strings.TrimSpace(kv[0])}
+	for _, v := range strings.Fields(kv[1]) {
+		if strings.HasPrefix(v, listenerPrefix) {
+			md.Listeners = append(md.Listeners, strings.TrimPrefix(v, listenerPrefix))
+		} else {
+			md.Clients = append(md.Clients, v)
+		}
+	}
+	return md, nil
+}
+
+// Set validate and save view, zones of replaced view are kept
+func (vs *Views) Set(md *models.View) error {
+
+	name := strings.ToLower(md.Name)
+	if name == "" {
+		return errViewName
+	}
+
+	v := &view{
+		md: models.View{
+			Name:      name,
+			Order:     md.Order,
+			Clients:   md.Clients,
+			Listeners: md.Listeners,
+		},
+	}
+
+	for _, c := range md.Clients {
+		e, err := compile(c)
+		if err != nil {
+			return fmt.Errorf("view: %w", err)
+		}
+		v.clients = append(v.clients, e)
+	}
+
+	for _, l := range md.Listeners {
+		host, port, err := net.SplitHostPort(l)
+		if err != nil {
+			host, port = l, ""
+		}
+		ip := net.ParseIP(host)
+		if ip == nil {
+			return fmt.Errorf("view: invalid listener %q", l)
+		}
+		v.listeners = append(v.listeners, listener{ip: ip, port: port})
+	}
+
+	vs.mux.Lock()
+	defer vs.mux.Unlock()
+
+	// servers on wildcard addresses do not know the local address of udp queries,
+	// so listeners of views are only matched on addresses servers are bound to
+	for i, l := range v.listeners {
+		if !vs.binds(l) {
+			return fmt.Errorf("%w: %q", errViewListener, md.Listeners[i])
+		}
+	}
+
+	if old, ok := vs.views[name]; ok {
+		v.zones = old.zones
+	} else {
+		v.zones = data.New()
+	}
+	vs.views[name] = v
+	vs.sort()
+	return nil
+}
+
+// Bind addresses like "192.0.2.1:53" servers are bound to, listeners of views must be one of them
+func (vs *Views) Bind(addrs []string) error {
+	var bound []listener
+	for _, addr := range addrs {
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			return fmt.Errorf("view: invalid listen address %q: %v", addr, err)
+		}
+		host, _, _ = strings.Cut(host, "%")
+		if ip := net.ParseIP(host); ip != nil && !ip.IsUnspecified() {
+			bound = append(bound, listener{ip: ip, port: port})
+		}
+	}
+	vs.mux.Lock()
+	vs.bound = bound
+	vs.mux.Unlock()
+	return nil
+}
+
+// binds server is bound to address of listener, on its port or on any port without port, caller holds lock
+func (vs *Views) binds(l listener) bool {
+	for _, b := range vs.bound {
+		if b.ip.Equal(l.ip) && (l.port == "" || l.port == b.port) {
+			return true
+		}
+	}
+	return false
+}
+
+// sort views in order of matching, slice is replaced so selections in progress keep old one,
+// caller holds lock
+func (vs *Views) sort() {
+	sorted := make([]*view, 0, len(vs.views))
+	for _, v := range vs.views {
+		sorted = append(sorted, v)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].md.Order != sorted[j].md.Order {
+			return sorted[i].md.Order < sorted[j].md.Order
+		}
+		return sorted[i].md.Name < sorted[j].md.Name
+	})
+	vs.sorted = sorted
+}
+
+// Get fetch view by name
+func (vs *Views) Get(name string) *models.View {
+	vs.mux.RLock()
+	defer vs.mux.RUnlock()
+	if v, ok := vs.views[strings.ToLower(name)]; ok {
+		md := v.md
+		return &md
+	}
+	return &models.View{}
+}
+
+// Delete view with its zones by name
+func (vs *Views) Delete(name string) error {
+	name = strings.ToLower(name)
+	vs.mux.Lock()
+	defer vs.mux.Unlock()
+	if _, ok := vs.views[name]; !ok {
+		return errViewUnknown
+	}
+	delete(vs.views, name)
+	vs.sort()
+	return nil
+}
+
+// GetMap get all views
+func (vs *Views) GetMap() map[string]models.View {
+	vs.mux.RLock()
+	mp := make(map[string]models.View, len(vs.views))
+	for k, v := range vs.views {
+		mp[k] = v.md
+	}
+	vs.mux.RUnlock()
+	return mp
+}
+
+// Zones zones of view by name
+func (vs *Views) Zones(name string) (*data.ResolvedData, error) {
+	vs.mux.RLock()
+	defer vs.mux.RUnlock()
+	v, ok := vs.views[strings.ToLower(name)]
+	if !ok {
+		return nil, errViewUnknown
+	}
+	return v.zones, nil
+}
+
+// Select zones of first view in order which matches listener and client, nil without matching view
+func (vs *Views) Select(acl *ACL, local net.Addr, ip net.IP, key string) *data.ResolvedData {
+
+	vs.mux.RLock()
+	list := vs.sorted
+	vs.mux.RUnlock()
+
+	for _, v := range list {
+		if !v.listens(local) {
+			continue
+		}
+		if len(v.clients) > 0 && !acl.matches(v.clients, ip, key) {
+			continue
+		}
+		return v.zones
+	}
+
+	return nil
+}
+
+// listens view is bound to local address or to any listener
+func (v *view) listens(local net.Addr) bool {
+
+	if len(v.listeners) == 0 {
+		return true
+	}
+	if local == nil {
+		return false
+	}
+
+	host, port, err := net.SplitHostPort(local.String())
+	if err != nil {
+		return false
+	}
+	ip := net.ParseIP(host)
+
+	for _, l := range v.listeners {
+		if l.ip.Equal(ip) && (l.port == "" || l.port == port) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,100 @@
+package dns
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+func TestViews_Select(t *testing.T) {
+
+	acl := NewACL()
+	md, _ := ParseACL("vpn=100.64.0.0/10")
+	if err := acl.Set(md); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+
+	views := NewViews()
+	if err := views.Bind([]string{"192.168.1.1:53", "[::1]:5353", "0.0.0.0:53"}); err != nil {
+		t.Fatalf("Bind() error = %v", err)
+	}
+	for i, v := range []string{
+		"internal=!10.9.0.0/16 10.0.0.0/8 vpn key:office.",
+		"lan=listener:192.168.1.1:53",
+		"external=any",
+	} {
+		md, err := ParseView(v)
+		if err != nil {
+			t.Fatalf("ParseView() error = %v", err)
+		}
+		md.Order = int64(i)
+		if err = views.Set(md); err != nil {
+			t.Fatalf("Set() error = %v", err)
+		}
+	}
+
+	// name of view by its zones
+	names := make(map[interface{}]string)
+	for name := range views.GetMap() {
+		zones, _ := views.Zones(name)
+		names[zones] = name
+	}
+
+	lan := &net.UDPAddr{IP: net.ParseIP("192.168.1.1"), Port: 53}
+	other := &net.UDPAddr{IP: net.ParseIP("192.0.2.1"), Port: 53}
+
+	tests := []struct {
+		name  string
+		local net.Addr
+		ip    string
+		key   string
+		want  string
+	}{
+		{"internal_network", other, "10.1.2.3", "", "internal"},
+		{"negated_network", other, "10.9.2.3", "", "external"},
+		{"acl_reference", other, "100.64.0.1", "", "internal"},
+		{"tsig_key", other, "203.0.113.1", "office.", "internal"},
+		{"listener", lan, "203.0.113.1", "", "lan"},
+		{"order_wins_over_listener", lan, "10.1.2.3", "", "internal"},
+		{"everybody_else", other, "203.0.113.1", "", "external"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			zones := views.Select(acl, tt.local, net.ParseIP(tt.ip), tt.key)
+			if got := names[zones]; got != tt.want {
+				t.Errorf("Select() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// zones are kept when view is replaced, but not when it is deleted
+	zones, _ := views.Zones("lan")
+	zones.Set("example.com.", &models.DNSEntry{Domain: "example.com."})
+	if err := views.Set(&models.View{Name: "lan", Listeners: []string{"192.168.1.1"}}); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	if zones, _ = views.Zones("lan"); zones.Get("example.com.").Domain == "" {
+		t.Errorf("zones of replaced view are lost")
+	}
+	if err := views.Delete("lan"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := views.Zones("lan"); err == nil {
+		t.Errorf("zones of deleted view exist")
+	}
+	if err := views.Set(&models.View{Name: "bad", Listeners: []string{"nope"}}); err == nil {
+		t.Errorf("Set() with invalid listener got no error")
+	}
+
+	// listeners are addresses servers are bound to, not wildcard addresses
+	for _, l := range []string{"192.168.1.2", "192.168.1.1:5353", "0.0.0.0:53", "[::1]:53"} {
+		if err := views.Set(&models.View{Name: "bad", Listeners: []string{l}}); !errors.Is(err, errViewListener) {
+			t.Errorf("Set() with listener %v error = %v, want %v", l, err, errViewListener)
+		}
+	}
+	if err := views.Set(&models.View{Name: "local", Listeners: []string{"::1"}}); err != nil {
+		t.Errorf("Set() with bound listener error = %v", err)
+	}
+}
@@ -0,0 +1,117 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Balance selection of answered addresses among healthy ones
+//
+// swagger:model balance
+type Balance struct {
+
+	// number of addresses of weighted policy, 1 by default
+	Count int64 `json:"count,omitempty"`
+
+	// all in stored order, round_robin rotates, weighted picks count addresses at random by weight, first_healthy answers the first one
+	// Enum: [all round_robin weighted first_healthy]
+	Policy string `json:"policy,omitempty"`
+
+	// weights of addresses for weighted policy, 1 by default, 0 drains address
+	Weights map[string]int64 `json:"weights,omitempty"`
+}
+
+// Validate validates this balance
+func (m *Balance) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validatePolicy(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var balanceTypePolicyPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["all","round_robin","weighted","first_healthy"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		balanceTypePolicyPropEnum = append(balanceTypePolicyPropEnum, v)
+	}
+}
+
+const (
+
+	// BalancePolicyAll captures enum value "all"
+	BalancePolicyAll string = "all"
+
+	// BalancePolicyRoundRobin captures enum value "round_robin"
+	BalancePolicyRoundRobin string = "round_robin"
+
+	// BalancePolicyWeighted captures enum value "weighted"
+	BalancePolicyWeighted string = "weighted"
+
+	// BalancePolicyFirstHealthy captures enum value "first_healthy"
+	BalancePolicyFirstHealthy string = "first_healthy"
+)
+
+// prop value enum
+func (m *Balance) validatePolicyEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, balanceTypePolicyPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Balance) validatePolicy(formats strfmt.Registry) error {
+	if swag.IsZero(m.Policy) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validatePolicyEnum("policy", "body", m.Policy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this balance based on context it is used
+func (m *Balance) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Balance) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Balance) UnmarshalBinary(b []byte) error {
+	var res Balance
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,53 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// BimiPolicy BIMI record of zone published at default._bimi, receivers show the logo only with DMARC policy quarantine or reject
+//
+// swagger:model bimi_policy
+type BimiPolicy struct {
+
+	// https URL of verified mark certificate in PEM format
+	Authority string `json:"authority,omitempty"`
+
+	// https URL of logo in SVG Tiny PS format
+	Location string `json:"location,omitempty"`
+}
+
+// Validate validates this bimi policy
+func (m *BimiPolicy) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this bimi policy based on context it is used
+func (m *BimiPolicy) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BimiPolicy) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BimiPolicy) UnmarshalBinary(b []byte) error {
+	var res BimiPolicy
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,179 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Blocklist blocklist
+//
+// swagger:model blocklist
+type Blocklist struct {
+
+	// names of the list are never blocked
+	Allow bool `json:"allow,omitempty"`
+
+	// entries
+	// Read Only: true
+	Entries int64 `json:"entries,omitempty"`
+
+	// format
+	// Enum: [hosts domains adblock]
+	Format string `json:"format,omitempty"`
+
+	// access lists of clients the list applies to, empty for all clients
+	Groups []string `json:"groups"`
+
+	// hits
+	// Read Only: true
+	Hits int64 `json:"hits,omitempty"`
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	// lists are checked in ascending order, hits count for the first matching block list
+	Order int64 `json:"order,omitempty"`
+
+	// path to local file or http(s) url
+	Source string `json:"source,omitempty"`
+
+	// updated
+	// Read Only: true
+	Updated string `json:"updated,omitempty"`
+}
+
+// Validate validates this blocklist
+func (m *Blocklist) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateFormat(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var blocklistTypeFormatPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["hosts","domains","adblock"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		blocklistTypeFormatPropEnum = append(blocklistTypeFormatPropEnum, v)
+	}
+}
+
+const (
+
+	// BlocklistFormatHosts captures enum value "hosts"
+	BlocklistFormatHosts string = "hosts"
+
+	// BlocklistFormatDomains captures enum value "domains"
+	BlocklistFormatDomains string = "domains"
+
+	// BlocklistFormatAdblock captures enum value "adblock"
+	BlocklistFormatAdblock string = "adblock"
+)
+
+// prop value enum
+func (m *Blocklist) validateFormatEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, blocklistTypeFormatPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Blocklist) validateFormat(formats strfmt.Registry) error {
+	if swag.IsZero(m.Format) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateFormatEnum("format", "body", m.Format); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this blocklist based on the context it is used
+func (m *Blocklist) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateEntries(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateHits(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateUpdated(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Blocklist) contextValidateEntries(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "entries", "body", int64(m.Entries)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Blocklist) contextValidateHits(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "hits", "body", int64(m.Hits)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Blocklist) contextValidateUpdated(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "updated", "body", string(m.Updated)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Blocklist) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Blocklist) UnmarshalBinary(b []byte) error {
+	var res Blocklist
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// Delegation delegation
+//
+// swagger:model delegation
+type Delegation struct {
+
+	// child zone, absolute or relative to zone like sub
+	Name string `json:"name,omitempty"`
+
+	// NS records of child zone, name servers below child zone need addresses
+	NameServers []*NameServer `json:"name_servers"`
+}
+
+// Validate validates this delegation
+func (m *Delegation) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateNameServers(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Delegation) validateNameServers(formats strfmt.Registry) error {
+	if swag.IsZero(m.NameServers) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.NameServers); i++ {
+		if swag.IsZero(m.NameServers[i]) { // not required
+			continue
+		}
+
+		if m.NameServers[i] != nil {
+			if err := m.NameServers[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("name_servers" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this delegation based on the context it is used
+func (m *Delegation) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateNameServers(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Delegation) contextValidateNameServers(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.NameServers); i++ {
+
+		if m.NameServers[i] != nil {
+			if err := m.NameServers[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("name_servers" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Delegation) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Delegation) UnmarshalBinary(b []byte) error {
+	var res Delegation
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
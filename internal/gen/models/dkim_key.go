@@ -0,0 +1,185 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// DkimKey dkim key
+//
+// swagger:model dkim_key
+type DkimKey struct {
+
+	// time of start of signing in RFC 3339 format
+	Activated string `json:"activated,omitempty"`
+
+	// rsa-2048 by default
+	// Enum: [rsa-2048 rsa-4096 ed25519]
+	Algorithm string `json:"algorithm,omitempty"`
+
+	// time of publishing in RFC 3339 format
+	Created string `json:"created,omitempty"`
+
+	// base64 private key, PKCS1 for rsa, PKCS8 for ed25519
+	PrivateKey string `json:"private_key,omitempty"`
+
+	// base64 public key of DKIM record, SubjectPublicKeyInfo for rsa, raw key for ed25519
+	PublicKey string `json:"public_key,omitempty"`
+
+	// time of end of signing in RFC 3339 format
+	Retired string `json:"retired,omitempty"`
+
+	// time of revocation in RFC 3339 format
+	Revoked string `json:"revoked,omitempty"`
+
+	// published at <selector>._domainkey of zone
+	Selector string `json:"selector,omitempty"`
+
+	// published waits for activation, active signs, retired and revoked stay published until retire period ends
+	// Enum: [published active retired revoked]
+	State string `json:"state,omitempty"`
+}
+
+// Validate validates this dkim key
+func (m *DkimKey) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAlgorithm(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateState(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var dkimKeyTypeAlgorithmPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["rsa-2048","rsa-4096","ed25519"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		dkimKeyTypeAlgorithmPropEnum = append(dkimKeyTypeAlgorithmPropEnum, v)
+	}
+}
+
+const (
+
+	// DkimKeyAlgorithmRsaDash2048 captures enum value "rsa-2048"
+	DkimKeyAlgorithmRsaDash2048 string = "rsa-2048"
+
+	// DkimKeyAlgorithmRsaDash4096 captures enum value "rsa-4096"
+	DkimKeyAlgorithmRsaDash4096 string = "rsa-4096"
+
+	// DkimKeyAlgorithmEd25519 captures enum value "ed25519"
+	DkimKeyAlgorithmEd25519 string = "ed25519"
+)
+
+// prop value enum
+func (m *DkimKey) validateAlgorithmEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, dkimKeyTypeAlgorithmPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *DkimKey) validateAlgorithm(formats strfmt.Registry) error {
+	if swag.IsZero(m.Algorithm) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateAlgorithmEnum("algorithm", "body", m.Algorithm); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var dkimKeyTypeStatePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["published","active","retired","revoked"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		dkimKeyTypeStatePropEnum = append(dkimKeyTypeStatePropEnum, v)
+	}
+}
+
+const (
+
+	// DkimKeyStatePublished captures enum value "published"
+	DkimKeyStatePublished string = "published"
+
+	// DkimKeyStateActive captures enum value "active"
+	DkimKeyStateActive string = "active"
+
+	// DkimKeyStateRetired captures enum value "retired"
+	DkimKeyStateRetired string = "retired"
+
+	// DkimKeyStateRevoked captures enum value "revoked"
+	DkimKeyStateRevoked string = "revoked"
+)
+
+// prop value enum
+func (m *DkimKey) validateStateEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, dkimKeyTypeStatePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *DkimKey) validateState(formats strfmt.Registry) error {
+	if swag.IsZero(m.State) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStateEnum("state", "body", m.State); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this dkim key based on context it is used
+func (m *DkimKey) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *DkimKey) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *DkimKey) UnmarshalBinary(b []byte) error {
+	var res DkimKey
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
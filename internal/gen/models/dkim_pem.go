@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// DkimPem dkim pem
+//
+// swagger:model dkim_pem
+type DkimPem struct {
+
+	// algorithm
+	Algorithm string `json:"algorithm,omitempty"`
+
+	// private key in PEM format for MTAs
+	Pem string `json:"pem,omitempty"`
+
+	// selector
+	Selector string `json:"selector,omitempty"`
+}
+
+// Validate validates this dkim pem
+func (m *DkimPem) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this dkim pem based on context it is used
+func (m *DkimPem) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *DkimPem) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *DkimPem) UnmarshalBinary(b []byte) error {
+	var res DkimPem
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,292 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// DmarcPolicy DMARC record of zone (RFC 7489)
+//
+// swagger:model dmarc_policy
+type DmarcPolicy struct {
+
+	// DKIM alignment, relaxed by default
+	// Enum: [relaxed strict]
+	Adkim string `json:"adkim,omitempty"`
+
+	// SPF alignment, relaxed by default
+	// Enum: [relaxed strict]
+	Aspf string `json:"aspf,omitempty"`
+
+	// percentage of messages the policy applies to, 100 by default
+	// Maximum: 100
+	// Minimum: 0
+	Pct *int64 `json:"pct,omitempty"`
+
+	// policy of zone, none by default
+	// Enum: [none quarantine reject]
+	Policy string `json:"policy,omitempty"`
+
+	// addresses of aggregate reports, mailto URIs or plain addresses
+	Rua []string `json:"rua"`
+
+	// addresses of failure reports, mailto URIs or plain addresses
+	Ruf []string `json:"ruf"`
+
+	// policy of subdomains, policy of zone by default
+	// Enum: [none quarantine reject]
+	SubdomainPolicy string `json:"subdomain_policy,omitempty"`
+}
+
+// Validate validates this dmarc policy
+func (m *DmarcPolicy) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAdkim(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateAspf(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePct(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePolicy(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSubdomainPolicy(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var dmarcPolicyTypeAdkimPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["relaxed","strict"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		dmarcPolicyTypeAdkimPropEnum = append(dmarcPolicyTypeAdkimPropEnum, v)
+	}
+}
+
+const (
+
+	// DmarcPolicyAdkimRelaxed captures enum value "relaxed"
+	DmarcPolicyAdkimRelaxed string = "relaxed"
+
+	// DmarcPolicyAdkimStrict captures enum value "strict"
+	DmarcPolicyAdkimStrict string = "strict"
+)
+
+// prop value enum
+func (m *DmarcPolicy) validateAdkimEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, dmarcPolicyTypeAdkimPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *DmarcPolicy) validateAdkim(formats strfmt.Registry) error {
+	if swag.IsZero(m.Adkim) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateAdkimEnum("adkim", "body", m.Adkim); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var dmarcPolicyTypeAspfPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["relaxed","strict"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		dmarcPolicyTypeAspfPropEnum = append(dmarcPolicyTypeAspfPropEnum, v)
+	}
+}
+
+const (
+
+	// DmarcPolicyAspfRelaxed captures enum value "relaxed"
+	DmarcPolicyAspfRelaxed string = "relaxed"
+
+	// DmarcPolicyAspfStrict captures enum value "strict"
+	DmarcPolicyAspfStrict string = "strict"
+)
+
+// prop value enum
+func (m *DmarcPolicy) validateAspfEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, dmarcPolicyTypeAspfPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *DmarcPolicy) validateAspf(formats strfmt.Registry) error {
+	if swag.IsZero(m.Aspf) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateAspfEnum("aspf", "body", m.Aspf); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *DmarcPolicy) validatePct(formats strfmt.Registry) error {
+	if swag.IsZero(m.Pct) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("pct", "body", *m.Pct, 0, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("pct", "body", *m.Pct, 100, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var dmarcPolicyTypePolicyPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["none","quarantine","reject"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		dmarcPolicyTypePolicyPropEnum = append(dmarcPolicyTypePolicyPropEnum, v)
+	}
+}
+
+const (
+
+	// DmarcPolicyPolicyNone captures enum value "none"
+	DmarcPolicyPolicyNone string = "none"
+
+	// DmarcPolicyPolicyQuarantine captures enum value "quarantine"
+	DmarcPolicyPolicyQuarantine string = "quarantine"
+
+	// DmarcPolicyPolicyReject captures enum value "reject"
+	DmarcPolicyPolicyReject string = "reject"
+)
+
+// prop value enum
+func (m *DmarcPolicy) validatePolicyEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, dmarcPolicyTypePolicyPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *DmarcPolicy) validatePolicy(formats strfmt.Registry) error {
+	if swag.IsZero(m.Policy) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validatePolicyEnum("policy", "body", m.Policy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var dmarcPolicyTypeSubdomainPolicyPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["none","quarantine","reject"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		dmarcPolicyTypeSubdomainPolicyPropEnum = append(dmarcPolicyTypeSubdomainPolicyPropEnum, v)
+	}
+}
+
+const (
+
+	// DmarcPolicySubdomainPolicyNone captures enum value "none"
+	DmarcPolicySubdomainPolicyNone string = "none"
+
+	// DmarcPolicySubdomainPolicyQuarantine captures enum value "quarantine"
+	DmarcPolicySubdomainPolicyQuarantine string = "quarantine"
+
+	// DmarcPolicySubdomainPolicyReject captures enum value "reject"
+	DmarcPolicySubdomainPolicyReject string = "reject"
+)
+
+// prop value enum
+func (m *DmarcPolicy) validateSubdomainPolicyEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, dmarcPolicyTypeSubdomainPolicyPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *DmarcPolicy) validateSubdomainPolicy(formats strfmt.Registry) error {
+	if swag.IsZero(m.SubdomainPolicy) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateSubdomainPolicyEnum("subdomain_policy", "body", m.SubdomainPolicy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this dmarc policy based on context it is used
+func (m *DmarcPolicy) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *DmarcPolicy) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *DmarcPolicy) UnmarshalBinary(b []byte) error {
+	var res DmarcPolicy
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
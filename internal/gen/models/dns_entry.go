@@ -7,9 +7,13 @@ package models
 
 import (
 	"context"
+	"encoding/json"
+	"strconv"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // DNSEntry dns entry
@@ -20,29 +24,648 @@ type DNSEntry struct {
 	// acme
 	Acme []string `json:"acme"`
 
-	// dkim private key
+	// reverse zone answers PTR queries with names of entries which have the queried address
+	AutoPtr bool `json:"auto_ptr,omitempty"`
+
+	// balance
+	Balance *Balance `json:"balance,omitempty"`
+
+	// child zones on other name servers, queries at or below them are answered with referrals
+	Delegations []*Delegation `json:"delegations"`
+
+	// DKIM keys of zone, a key of selector mail is generated with zone
+	DkimKeys []*DkimKey `json:"dkim_keys"`
+
+	// private key of active DKIM key, see dkim_keys
 	DkimPrivateKey string `json:"dkim_private_key,omitempty"`
 
-	// dkim public key
+	// public key of active DKIM key, see dkim_keys
 	DkimPublicKey string `json:"dkim_public_key,omitempty"`
 
 	// domain
 	Domain string `json:"domain,omitempty"`
 
+	// addresses for clients by location of GeoIP database, asn before country before continent, ipv4s and ipv6s are the default
+	Geo []*GeoRecords `json:"geo"`
+
+	// health check
+	HealthCheck *HealthCheck `json:"health_check,omitempty"`
+
 	// ipv4s
 	Ipv4s []string `json:"ipv4s"`
 
-	// ipv6s
+	// AAAA records for ipv4s without ipv6s, none by default, mapped as ::ffff:a.b.c.d, nat64 under nat64_prefix
+	// Enum: [none mapped nat64]
+	IPV6Synthesis string `json:"ipv6_synthesis,omitempty"`
+
+	// ipv6 addresses, AAAA records are synthesized from ipv4s only without them and with ipv6_synthesis
 	Ipv6s []string `json:"ipv6s"`
+
+	// mail
+	Mail *MailPolicy `json:"mail,omitempty"`
+
+	// NS records of zone with addresses of name servers, ns1 and ns2 of zone by default
+	NameServers []*NameServer `json:"name_servers"`
+
+	// prefix of nat64 synthesis of length 32, 40, 48, 56, 64 or 96 (RFC 6052), 64:ff9b::/96 by default
+	Nat64Prefix string `json:"nat64_prefix,omitempty"`
+
+	// records of reverse zone, CNAMEs of parent zone and PTRs of child zone for classless delegation (RFC 2317)
+	Ptrs []*PtrRecord `json:"ptrs"`
+
+	// SRV records of services in zone, addresses of targets in zone are sent in additional section
+	Srvs []*SrvRecord `json:"srvs"`
+
+	// addresses for clients in networks, longest prefix wins, client subnet of EDNS is used when present
+	Subnets []*SubnetRecords `json:"subnets"`
+
+	// TXT records matched by exact owner name, an SPF or DMARC record replaces the generated one
+	Txts []*TxtRecord `json:"txts"`
 }
 
 // Validate validates this dns entry
 func (m *DNSEntry) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateBalance(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDelegations(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDkimKeys(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateGeo(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHealthCheck(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateIPV6Synthesis(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMail(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNameServers(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePtrs(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSrvs(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSubnets(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTxts(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *DNSEntry) validateBalance(formats strfmt.Registry) error {
+	if swag.IsZero(m.Balance) { // not required
+		return nil
+	}
+
+	if m.Balance != nil {
+		if err := m.Balance.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("balance")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *DNSEntry) validateDelegations(formats strfmt.Registry) error {
+	if swag.IsZero(m.Delegations) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Delegations); i++ {
+		if swag.IsZero(m.Delegations[i]) { // not required
+			continue
+		}
+
+		if m.Delegations[i] != nil {
+			if err := m.Delegations[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("delegations" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *DNSEntry) validateDkimKeys(formats strfmt.Registry) error {
+	if swag.IsZero(m.DkimKeys) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.DkimKeys); i++ {
+		if swag.IsZero(m.DkimKeys[i]) { // not required
+			continue
+		}
+
+		if m.DkimKeys[i] != nil {
+			if err := m.DkimKeys[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("dkim_keys" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *DNSEntry) validateGeo(formats strfmt.Registry) error {
+	if swag.IsZero(m.Geo) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Geo); i++ {
+		if swag.IsZero(m.Geo[i]) { // not required
+			continue
+		}
+
+		if m.Geo[i] != nil {
+			if err := m.Geo[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("geo" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *DNSEntry) validateHealthCheck(formats strfmt.Registry) error {
+	if swag.IsZero(m.HealthCheck) { // not required
+		return nil
+	}
+
+	if m.HealthCheck != nil {
+		if err := m.HealthCheck.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("health_check")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+var dnsEntryTypeIPV6SynthesisPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["none","mapped","nat64"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		dnsEntryTypeIPV6SynthesisPropEnum = append(dnsEntryTypeIPV6SynthesisPropEnum, v)
+	}
+}
+
+const (
+
+	// DNSEntryIPV6SynthesisNone captures enum value "none"
+	DNSEntryIPV6SynthesisNone string = "none"
+
+	// DNSEntryIPV6SynthesisMapped captures enum value "mapped"
+	DNSEntryIPV6SynthesisMapped string = "mapped"
+
+	// DNSEntryIPV6SynthesisNat64 captures enum value "nat64"
+	DNSEntryIPV6SynthesisNat64 string = "nat64"
+)
+
+// prop value enum
+func (m *DNSEntry) validateIPV6SynthesisEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, dnsEntryTypeIPV6SynthesisPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *DNSEntry) validateIPV6Synthesis(formats strfmt.Registry) error {
+	if swag.IsZero(m.IPV6Synthesis) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateIPV6SynthesisEnum("ipv6_synthesis", "body", m.IPV6Synthesis); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *DNSEntry) validateMail(formats strfmt.Registry) error {
+	if swag.IsZero(m.Mail) { // not required
+		return nil
+	}
+
+	if m.Mail != nil {
+		if err := m.Mail.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("mail")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *DNSEntry) validateNameServers(formats strfmt.Registry) error {
+	if swag.IsZero(m.NameServers) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.NameServers); i++ {
+		if swag.IsZero(m.NameServers[i]) { // not required
+			continue
+		}
+
+		if m.NameServers[i] != nil {
+			if err := m.NameServers[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("name_servers" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *DNSEntry) validatePtrs(formats strfmt.Registry) error {
+	if swag.IsZero(m.Ptrs) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Ptrs); i++ {
+		if swag.IsZero(m.Ptrs[i]) { // not required
+			continue
+		}
+
+		if m.Ptrs[i] != nil {
+			if err := m.Ptrs[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("ptrs" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *DNSEntry) validateSrvs(formats strfmt.Registry) error {
+	if swag.IsZero(m.Srvs) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Srvs); i++ {
+		if swag.IsZero(m.Srvs[i]) { // not required
+			continue
+		}
+
+		if m.Srvs[i] != nil {
+			if err := m.Srvs[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("srvs" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
-// ContextValidate validates this dns entry based on context it is used
+func (m *DNSEntry) validateSubnets(formats strfmt.Registry) error {
+	if swag.IsZero(m.Subnets) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Subnets); i++ {
+		if swag.IsZero(m.Subnets[i]) { // not required
+			continue
+		}
+
+		if m.Subnets[i] != nil {
+			if err := m.Subnets[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("subnets" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *DNSEntry) validateTxts(formats strfmt.Registry) error {
+	if swag.IsZero(m.Txts) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Txts); i++ {
+		if swag.IsZero(m.Txts[i]) { // not required
+			continue
+		}
+
+		if m.Txts[i] != nil {
+			if err := m.Txts[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("txts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this dns entry based on the context it is used
 func (m *DNSEntry) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateBalance(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateDelegations(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateDkimKeys(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateGeo(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateHealthCheck(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateMail(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateNameServers(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidatePtrs(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateSrvs(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateSubnets(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateTxts(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *DNSEntry) contextValidateBalance(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Balance != nil {
+		if err := m.Balance.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("balance")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *DNSEntry) contextValidateDelegations(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Delegations); i++ {
+
+		if m.Delegations[i] != nil {
+			if err := m.Delegations[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("delegations" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *DNSEntry) contextValidateDkimKeys(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.DkimKeys); i++ {
+
+		if m.DkimKeys[i] != nil {
+			if err := m.DkimKeys[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("dkim_keys" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *DNSEntry) contextValidateGeo(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Geo); i++ {
+
+		if m.Geo[i] != nil {
+			if err := m.Geo[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("geo" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *DNSEntry) contextValidateHealthCheck(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.HealthCheck != nil {
+		if err := m.HealthCheck.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("health_check")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *DNSEntry) contextValidateMail(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Mail != nil {
+		if err := m.Mail.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("mail")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *DNSEntry) contextValidateNameServers(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.NameServers); i++ {
+
+		if m.NameServers[i] != nil {
+			if err := m.NameServers[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("name_servers" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *DNSEntry) contextValidatePtrs(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Ptrs); i++ {
+
+		if m.Ptrs[i] != nil {
+			if err := m.Ptrs[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("ptrs" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *DNSEntry) contextValidateSrvs(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Srvs); i++ {
+
+		if m.Srvs[i] != nil {
+			if err := m.Srvs[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("srvs" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *DNSEntry) contextValidateSubnets(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Subnets); i++ {
+
+		if m.Subnets[i] != nil {
+			if err := m.Subnets[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("subnets" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *DNSEntry) contextValidateTxts(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Txts); i++ {
+
+		if m.Txts[i] != nil {
+			if err := m.Txts[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("txts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
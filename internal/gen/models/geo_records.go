@@ -0,0 +1,62 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// GeoRecords geo records
+//
+// swagger:model geo_records
+type GeoRecords struct {
+
+	// autonomous system numbers
+	Asns []int64 `json:"asns"`
+
+	// continent codes like EU
+	Continents []string `json:"continents"`
+
+	// ISO 3166 country codes like DE
+	Countries []string `json:"countries"`
+
+	// ipv4s
+	Ipv4s []string `json:"ipv4s"`
+
+	// ipv6s
+	Ipv6s []string `json:"ipv6s"`
+}
+
+// Validate validates this geo records
+func (m *GeoRecords) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this geo records based on context it is used
+func (m *GeoRecords) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *GeoRecords) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *GeoRecords) UnmarshalBinary(b []byte) error {
+	var res GeoRecords
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
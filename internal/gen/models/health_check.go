@@ -0,0 +1,194 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// HealthCheck check of every address of dns entry, only healthy addresses are answered
+//
+// swagger:model health_check
+type HealthCheck struct {
+
+	// answer with all addresses or with none when every address is down
+	// Enum: [all none]
+	AllDown string `json:"all_down,omitempty"`
+
+	// status of http response, 200 by default
+	ExpectStatus int64 `json:"expect_status,omitempty"`
+
+	// failed checks until address is down, 3 by default
+	Fall int64 `json:"fall,omitempty"`
+
+	// host header and server name of http request, domain by default
+	Host string `json:"host,omitempty"`
+
+	// seconds between checks, 10 by default
+	Interval int64 `json:"interval,omitempty"`
+
+	// path of http request
+	Path string `json:"path,omitempty"`
+
+	// port of check, 80 for http, 443 for https, 53 for dns by default
+	Port int64 `json:"port,omitempty"`
+
+	// name of dns query, domain by default
+	QueryName string `json:"query_name,omitempty"`
+
+	// type of dns query, A by default
+	QueryType string `json:"query_type,omitempty"`
+
+	// successful checks until address is healthy again, 2 by default
+	Rise int64 `json:"rise,omitempty"`
+
+	// seconds until check fails, 2 by default
+	Timeout int64 `json:"timeout,omitempty"`
+
+	// tcp connects, ping accepts refused connections too, http and https expect status, dns expects NOERROR
+	// Enum: [tcp ping http https dns]
+	Type string `json:"type,omitempty"`
+}
+
+// Validate validates this health check
+func (m *HealthCheck) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAllDown(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var healthCheckTypeAllDownPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["all","none"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		healthCheckTypeAllDownPropEnum = append(healthCheckTypeAllDownPropEnum, v)
+	}
+}
+
+const (
+
+	// HealthCheckAllDownAll captures enum value "all"
+	HealthCheckAllDownAll string = "all"
+
+	// HealthCheckAllDownNone captures enum value "none"
+	HealthCheckAllDownNone string = "none"
+)
+
+// prop value enum
+func (m *HealthCheck) validateAllDownEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, healthCheckTypeAllDownPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *HealthCheck) validateAllDown(formats strfmt.Registry) error {
+	if swag.IsZero(m.AllDown) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateAllDownEnum("all_down", "body", m.AllDown); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var healthCheckTypeTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["tcp","ping","http","https","dns"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		healthCheckTypeTypePropEnum = append(healthCheckTypeTypePropEnum, v)
+	}
+}
+
+const (
+
+	// HealthCheckTypeTCP captures enum value "tcp"
+	HealthCheckTypeTCP string = "tcp"
+
+	// HealthCheckTypePing captures enum value "ping"
+	HealthCheckTypePing string = "ping"
+
+	// HealthCheckTypeHTTP captures enum value "http"
+	HealthCheckTypeHTTP string = "http"
+
+	// HealthCheckTypeHTTPS captures enum value "https"
+	HealthCheckTypeHTTPS string = "https"
+
+	// HealthCheckTypeDNS captures enum value "dns"
+	HealthCheckTypeDNS string = "dns"
+)
+
+// prop value enum
+func (m *HealthCheck) validateTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, healthCheckTypeTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *HealthCheck) validateType(formats strfmt.Registry) error {
+	if swag.IsZero(m.Type) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateTypeEnum("type", "body", m.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this health check based on context it is used
+func (m *HealthCheck) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *HealthCheck) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *HealthCheck) UnmarshalBinary(b []byte) error {
+	var res HealthCheck
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,89 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// HealthStatus health status
+//
+// swagger:model health_status
+type HealthStatus struct {
+
+	// address
+	Address string `json:"address,omitempty"`
+
+	// time of last check
+	// Format: date-time
+	Checked strfmt.DateTime `json:"checked,omitempty"`
+
+	// error of last failed check
+	Error string `json:"error,omitempty"`
+
+	// failed checks in a row
+	Failures int64 `json:"failures,omitempty"`
+
+	// healthy
+	Healthy bool `json:"healthy"`
+
+	// successful checks in a row
+	Successes int64 `json:"successes,omitempty"`
+}
+
+// Validate validates this health status
+func (m *HealthStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateChecked(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *HealthStatus) validateChecked(formats strfmt.Registry) error {
+	if swag.IsZero(m.Checked) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("checked", "body", "date-time", m.Checked.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this health status based on context it is used
+func (m *HealthStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *HealthStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *HealthStatus) UnmarshalBinary(b []byte) error {
+	var res HealthStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,268 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// MailPolicy mail policy of zone, kept by updates of dns entry without it
+//
+// swagger:model mail_policy
+type MailPolicy struct {
+
+	// bimi
+	Bimi *BimiPolicy `json:"bimi,omitempty"`
+
+	// dmarc
+	Dmarc *DmarcPolicy `json:"dmarc,omitempty"`
+
+	// mta sts
+	MtaSts *MtaStsPolicy `json:"mta_sts,omitempty"`
+
+	// spf
+	Spf *SpfPolicy `json:"spf,omitempty"`
+
+	// tls rpt
+	TLSRpt *TLSRptPolicy `json:"tls_rpt,omitempty"`
+}
+
+// Validate validates this mail policy
+func (m *MailPolicy) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateBimi(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDmarc(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMtaSts(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSpf(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTLSRpt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *MailPolicy) validateBimi(formats strfmt.Registry) error {
+	if swag.IsZero(m.Bimi) { // not required
+		return nil
+	}
+
+	if m.Bimi != nil {
+		if err := m.Bimi.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("bimi")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *MailPolicy) validateDmarc(formats strfmt.Registry) error {
+	if swag.IsZero(m.Dmarc) { // not required
+		return nil
+	}
+
+	if m.Dmarc != nil {
+		if err := m.Dmarc.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("dmarc")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *MailPolicy) validateMtaSts(formats strfmt.Registry) error {
+	if swag.IsZero(m.MtaSts) { // not required
+		return nil
+	}
+
+	if m.MtaSts != nil {
+		if err := m.MtaSts.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("mta_sts")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *MailPolicy) validateSpf(formats strfmt.Registry) error {
+	if swag.IsZero(m.Spf) { // not required
+		return nil
+	}
+
+	if m.Spf != nil {
+		if err := m.Spf.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("spf")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *MailPolicy) validateTLSRpt(formats strfmt.Registry) error {
+	if swag.IsZero(m.TLSRpt) { // not required
+		return nil
+	}
+
+	if m.TLSRpt != nil {
+		if err := m.TLSRpt.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("tls_rpt")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this mail policy based on the context it is used
+func (m *MailPolicy) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateBimi(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateDmarc(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateMtaSts(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateSpf(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateTLSRpt(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *MailPolicy) contextValidateBimi(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Bimi != nil {
+		if err := m.Bimi.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("bimi")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *MailPolicy) contextValidateDmarc(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Dmarc != nil {
+		if err := m.Dmarc.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("dmarc")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *MailPolicy) contextValidateMtaSts(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.MtaSts != nil {
+		if err := m.MtaSts.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("mta_sts")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *MailPolicy) contextValidateSpf(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Spf != nil {
+		if err := m.Spf.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("spf")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *MailPolicy) contextValidateTLSRpt(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.TLSRpt != nil {
+		if err := m.TLSRpt.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("tls_rpt")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *MailPolicy) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *MailPolicy) UnmarshalBinary(b []byte) error {
+	var res MailPolicy
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
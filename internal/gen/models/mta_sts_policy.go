@@ -0,0 +1,136 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// MtaStsPolicy MTA-STS policy of zone (RFC 8461), published at _mta-sts and served as mta-sts.txt over https by mdns
+//
+// swagger:model mta_sts_policy
+type MtaStsPolicy struct {
+
+	// seconds senders cache the policy, one week by default
+	// Maximum: 3.15576e+07
+	// Minimum: 0
+	MaxAge *int64 `json:"max_age,omitempty"`
+
+	// mode of policy, testing by default
+	// Enum: [enforce testing none]
+	Mode string `json:"mode,omitempty"`
+
+	// names of mail servers, a leading *. matches one label, MX of zone by default
+	Mx []string `json:"mx"`
+}
+
+// Validate validates this mta sts policy
+func (m *MtaStsPolicy) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMaxAge(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMode(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *MtaStsPolicy) validateMaxAge(formats strfmt.Registry) error {
+	if swag.IsZero(m.MaxAge) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("max_age", "body", *m.MaxAge, 0, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("max_age", "body", *m.MaxAge, 3.15576e+07, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var mtaStsPolicyTypeModePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["enforce","testing","none"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		mtaStsPolicyTypeModePropEnum = append(mtaStsPolicyTypeModePropEnum, v)
+	}
+}
+
+const (
+
+	// MtaStsPolicyModeEnforce captures enum value "enforce"
+	MtaStsPolicyModeEnforce string = "enforce"
+
+	// MtaStsPolicyModeTesting captures enum value "testing"
+	MtaStsPolicyModeTesting string = "testing"
+
+	// MtaStsPolicyModeNone captures enum value "none"
+	MtaStsPolicyModeNone string = "none"
+)
+
+// prop value enum
+func (m *MtaStsPolicy) validateModeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, mtaStsPolicyTypeModePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *MtaStsPolicy) validateMode(formats strfmt.Registry) error {
+	if swag.IsZero(m.Mode) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateModeEnum("mode", "body", m.Mode); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this mta sts policy based on context it is used
+func (m *MtaStsPolicy) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *MtaStsPolicy) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *MtaStsPolicy) UnmarshalBinary(b []byte) error {
+	var res MtaStsPolicy
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NameServer name server
+//
+// swagger:model name_server
+type NameServer struct {
+
+	// addresses of name server below zone, sent as glue
+	Ipv4s []string `json:"ipv4s"`
+
+	// addresses of name server below zone, sent as glue
+	Ipv6s []string `json:"ipv6s"`
+
+	// host name of name server, absolute or relative to zone like ns1
+	Name string `json:"name,omitempty"`
+}
+
+// Validate validates this name server
+func (m *NameServer) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this name server based on context it is used
+func (m *NameServer) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NameServer) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NameServer) UnmarshalBinary(b []byte) error {
+	var res NameServer
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
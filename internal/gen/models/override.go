@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// Override override
+//
+// swagger:model override
+type Override struct {
+
+	// ipv4s
+	Ipv4s []string `json:"ipv4s"`
+
+	// ipv6s
+	Ipv6s []string `json:"ipv6s"`
+
+	// name
+	Name string `json:"name,omitempty"`
+}
+
+// Validate validates this override
+func (m *Override) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this override based on context it is used
+func (m *Override) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Override) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Override) UnmarshalBinary(b []byte) error {
+	var res Override
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
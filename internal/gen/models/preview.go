@@ -0,0 +1,68 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// Preview preview
+//
+// swagger:model preview
+type Preview struct {
+
+	// asn
+	Asn int64 `json:"asn,omitempty"`
+
+	// continent
+	Continent string `json:"continent,omitempty"`
+
+	// country
+	Country string `json:"country,omitempty"`
+
+	// domain
+	Domain string `json:"domain,omitempty"`
+
+	// ip
+	IP string `json:"ip,omitempty"`
+
+	// ipv4s
+	Ipv4s []string `json:"ipv4s"`
+
+	// ipv6s
+	Ipv6s []string `json:"ipv6s"`
+}
+
+// Validate validates this preview
+func (m *Preview) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this preview based on context it is used
+func (m *Preview) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Preview) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Preview) UnmarshalBinary(b []byte) error {
+	var res Preview
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// PtrRecord ptr record
+//
+// swagger:model ptr_record
+type PtrRecord struct {
+
+	// name in zone of classless delegation instead of ptr
+	Cname string `json:"cname,omitempty"`
+
+	// owner name, absolute or relative to reverse zone like 1 or 1.0-25
+	Name string `json:"name,omitempty"`
+
+	// host name of address
+	Ptr string `json:"ptr,omitempty"`
+}
+
+// Validate validates this ptr record
+func (m *PtrRecord) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this ptr record based on context it is used
+func (m *PtrRecord) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PtrRecord) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PtrRecord) UnmarshalBinary(b []byte) error {
+	var res PtrRecord
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,146 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Rrl rrl
+//
+// swagger:model rrl
+type Rrl struct {
+
+	// dropped
+	// Read Only: true
+	Dropped int64 `json:"dropped,omitempty"`
+
+	// error answers per second for one client prefix, 0 takes responses_per_second
+	ErrorsPerSecond int64 `json:"errors_per_second,omitempty"`
+
+	// access lists of clients which are never limited
+	Exempt []string `json:"exempt"`
+
+	// exempted
+	// Read Only: true
+	Exempted int64 `json:"exempted,omitempty"`
+
+	// ipv4 prefix length
+	IPV4PrefixLength int64 `json:"ipv4_prefix_length,omitempty"`
+
+	// ipv6 prefix length
+	IPV6PrefixLength int64 `json:"ipv6_prefix_length,omitempty"`
+
+	// nxdomain answers per second for one client prefix and zone, 0 takes responses_per_second
+	NxdomainsPerSecond int64 `json:"nxdomains_per_second,omitempty"`
+
+	// responses
+	// Read Only: true
+	Responses int64 `json:"responses,omitempty"`
+
+	// answers and empty answers per second for one client prefix and name, 0 disables limiting
+	ResponsesPerSecond int64 `json:"responses_per_second,omitempty"`
+
+	// every slip limited answer is sent truncated instead of dropped, 0 drops all
+	Slip int64 `json:"slip,omitempty"`
+
+	// slipped
+	// Read Only: true
+	Slipped int64 `json:"slipped,omitempty"`
+
+	// seconds of over limit answers which are remembered
+	Window int64 `json:"window,omitempty"`
+}
+
+// Validate validates this rrl
+func (m *Rrl) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validate this rrl based on the context it is used
+func (m *Rrl) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateDropped(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateExempted(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateResponses(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateSlipped(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Rrl) contextValidateDropped(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "dropped", "body", int64(m.Dropped)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Rrl) contextValidateExempted(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "exempted", "body", int64(m.Exempted)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Rrl) contextValidateResponses(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "responses", "body", int64(m.Responses)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Rrl) contextValidateSlipped(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "slipped", "body", int64(m.Slipped)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Rrl) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Rrl) UnmarshalBinary(b []byte) error {
+	var res Rrl
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// RrlUpdate settings of response rate limiting, omitted settings are kept
+//
+// swagger:model rrl_update
+type RrlUpdate struct {
+
+	// errors per second
+	ErrorsPerSecond *int64 `json:"errors_per_second,omitempty"`
+
+	// exempt
+	Exempt []string `json:"exempt"`
+
+	// ipv4 prefix length
+	IPV4PrefixLength *int64 `json:"ipv4_prefix_length,omitempty"`
+
+	// ipv6 prefix length
+	IPV6PrefixLength *int64 `json:"ipv6_prefix_length,omitempty"`
+
+	// nxdomains per second
+	NxdomainsPerSecond *int64 `json:"nxdomains_per_second,omitempty"`
+
+	// responses per second
+	ResponsesPerSecond *int64 `json:"responses_per_second,omitempty"`
+
+	// slip
+	Slip *int64 `json:"slip,omitempty"`
+
+	// window
+	Window *int64 `json:"window,omitempty"`
+}
+
+// Validate validates this rrl update
+func (m *RrlUpdate) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this rrl update based on context it is used
+func (m *RrlUpdate) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *RrlUpdate) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *RrlUpdate) UnmarshalBinary(b []byte) error {
+	var res RrlUpdate
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
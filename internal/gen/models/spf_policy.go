@@ -0,0 +1,114 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// SpfPolicy SPF record of zone (RFC 7208), without mechanisms and includes the addresses of zone, a and mx are allowed
+//
+// swagger:model spf_policy
+type SpfPolicy struct {
+
+	// domains of include mechanisms like _spf.google.com
+	Includes []string `json:"includes"`
+
+	// mechanisms and modifiers before all like a, mx, ip4:192.0.2.0/24, ip6:2001:db8::/32, exists:%{i}.example.com
+	Mechanisms []string `json:"mechanisms"`
+
+	// qualifier of all mechanism, softfail by default
+	// Enum: [fail softfail neutral]
+	Qualifier string `json:"qualifier,omitempty"`
+}
+
+// Validate validates this spf policy
+func (m *SpfPolicy) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateQualifier(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var spfPolicyTypeQualifierPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["fail","softfail","neutral"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		spfPolicyTypeQualifierPropEnum = append(spfPolicyTypeQualifierPropEnum, v)
+	}
+}
+
+const (
+
+	// SpfPolicyQualifierFail captures enum value "fail"
+	SpfPolicyQualifierFail string = "fail"
+
+	// SpfPolicyQualifierSoftfail captures enum value "softfail"
+	SpfPolicyQualifierSoftfail string = "softfail"
+
+	// SpfPolicyQualifierNeutral captures enum value "neutral"
+	SpfPolicyQualifierNeutral string = "neutral"
+)
+
+// prop value enum
+func (m *SpfPolicy) validateQualifierEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, spfPolicyTypeQualifierPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *SpfPolicy) validateQualifier(formats strfmt.Registry) error {
+	if swag.IsZero(m.Qualifier) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateQualifierEnum("qualifier", "body", m.Qualifier); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this spf policy based on context it is used
+func (m *SpfPolicy) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SpfPolicy) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SpfPolicy) UnmarshalBinary(b []byte) error {
+	var res SpfPolicy
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
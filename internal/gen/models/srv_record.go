@@ -0,0 +1,62 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// SrvRecord srv record
+//
+// swagger:model srv_record
+type SrvRecord struct {
+
+	// owner name of service, absolute or relative to zone like _sip._tcp
+	Name string `json:"name,omitempty"`
+
+	// port
+	Port int64 `json:"port,omitempty"`
+
+	// priority
+	Priority int64 `json:"priority"`
+
+	// host name of service, absolute or relative to zone, . when service is not available
+	Target string `json:"target,omitempty"`
+
+	// weight
+	Weight int64 `json:"weight"`
+}
+
+// Validate validates this srv record
+func (m *SrvRecord) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this srv record based on context it is used
+func (m *SrvRecord) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SrvRecord) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SrvRecord) UnmarshalBinary(b []byte) error {
+	var res SrvRecord
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// SubnetRecords subnet records
+//
+// swagger:model subnet_records
+type SubnetRecords struct {
+
+	// ipv4s
+	Ipv4s []string `json:"ipv4s"`
+
+	// ipv6s
+	Ipv6s []string `json:"ipv6s"`
+
+	// network in CIDR notation
+	Subnet string `json:"subnet,omitempty"`
+}
+
+// Validate validates this subnet records
+func (m *SubnetRecords) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this subnet records based on context it is used
+func (m *SubnetRecords) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SubnetRecords) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SubnetRecords) UnmarshalBinary(b []byte) error {
+	var res SubnetRecords
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
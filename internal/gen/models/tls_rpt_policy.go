@@ -0,0 +1,50 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// TLSRptPolicy SMTP TLS reporting of zone (RFC 8460), published at _smtp._tls
+//
+// swagger:model tls_rpt_policy
+type TLSRptPolicy struct {
+
+	// destinations of reports, mailto or https URIs or plain addresses
+	Rua []string `json:"rua"`
+}
+
+// Validate validates this tls rpt policy
+func (m *TLSRptPolicy) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this tls rpt policy based on context it is used
+func (m *TLSRptPolicy) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TLSRptPolicy) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TLSRptPolicy) UnmarshalBinary(b []byte) error {
+	var res TLSRptPolicy
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
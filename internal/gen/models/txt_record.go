@@ -0,0 +1,53 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// TxtRecord txt record
+//
+// swagger:model txt_record
+type TxtRecord struct {
+
+	// owner name, absolute or relative to zone like _github-challenge, @ for zone
+	Name string `json:"name,omitempty"`
+
+	// strings of one TXT record, strings over 255 bytes are split
+	Values []string `json:"values"`
+}
+
+// Validate validates this txt record
+func (m *TxtRecord) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this txt record based on context it is used
+func (m *TxtRecord) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TxtRecord) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TxtRecord) UnmarshalBinary(b []byte) error {
+	var res TxtRecord
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,59 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// View view
+//
+// swagger:model view
+type View struct {
+
+	// networks, addresses, key:<tsig key>, names of access lists, ! negates, any client when empty
+	Clients []string `json:"clients"`
+
+	// local addresses "ip" or "ip:port" of listeners, which servers are bound to by LISTEN, any listener when empty
+	Listeners []string `json:"listeners"`
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	// views are matched in ascending order, the first matching view answers with its zones
+	Order int64 `json:"order,omitempty"`
+}
+
+// Validate validates this view
+func (m *View) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this view based on context it is used
+func (m *View) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *View) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *View) UnmarshalBinary(b []byte) error {
+	var res View
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
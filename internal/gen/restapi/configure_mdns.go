@@ -42,31 +42,196 @@ func configureAPI(api *operations.MdnsAPI) http.Handler {
 
 	api.JSONProducer = runtime.JSONProducer()
 
+	if api.AddAddACLHandler == nil {
+		api.AddAddACLHandler = add.AddACLHandlerFunc(func(params add.AddACLParams) middleware.Responder {
+			return middleware.NotImplemented("operation add.AddACL has not yet been implemented")
+		})
+	}
+	if api.AddAddBlocklistHandler == nil {
+		api.AddAddBlocklistHandler = add.AddBlocklistHandlerFunc(func(params add.AddBlocklistParams) middleware.Responder {
+			return middleware.NotImplemented("operation add.AddBlocklist has not yet been implemented")
+		})
+	}
+	if api.AddAddDkimKeyHandler == nil {
+		api.AddAddDkimKeyHandler = add.AddDkimKeyHandlerFunc(func(params add.AddDkimKeyParams) middleware.Responder {
+			return middleware.NotImplemented("operation add.AddDkimKey has not yet been implemented")
+		})
+	}
 	if api.AddAddDNSEntryHandler == nil {
 		api.AddAddDNSEntryHandler = add.AddDNSEntryHandlerFunc(func(params add.AddDNSEntryParams) middleware.Responder {
 			return middleware.NotImplemented("operation add.AddDNSEntry has not yet been implemented")
 		})
 	}
+	if api.AddAddOverrideHandler == nil {
+		api.AddAddOverrideHandler = add.AddOverrideHandlerFunc(func(params add.AddOverrideParams) middleware.Responder {
+			return middleware.NotImplemented("operation add.AddOverride has not yet been implemented")
+		})
+	}
+	if api.AddAddViewHandler == nil {
+		api.AddAddViewHandler = add.AddViewHandlerFunc(func(params add.AddViewParams) middleware.Responder {
+			return middleware.NotImplemented("operation add.AddView has not yet been implemented")
+		})
+	}
+	if api.AddAddViewDNSEntryHandler == nil {
+		api.AddAddViewDNSEntryHandler = add.AddViewDNSEntryHandlerFunc(func(params add.AddViewDNSEntryParams) middleware.Responder {
+			return middleware.NotImplemented("operation add.AddViewDNSEntry has not yet been implemented")
+		})
+	}
+	if api.DeleteDeleteACLHandler == nil {
+		api.DeleteDeleteACLHandler = delete.DeleteACLHandlerFunc(func(params delete.DeleteACLParams) middleware.Responder {
+			return middleware.NotImplemented("operation delete.DeleteACL has not yet been implemented")
+		})
+	}
+	if api.DeleteDeleteBlocklistHandler == nil {
+		api.DeleteDeleteBlocklistHandler = delete.DeleteBlocklistHandlerFunc(func(params delete.DeleteBlocklistParams) middleware.Responder {
+			return middleware.NotImplemented("operation delete.DeleteBlocklist has not yet been implemented")
+		})
+	}
 	if api.DeleteDeleteDNSEntryHandler == nil {
 		api.DeleteDeleteDNSEntryHandler = delete.DeleteDNSEntryHandlerFunc(func(params delete.DeleteDNSEntryParams) middleware.Responder {
 			return middleware.NotImplemented("operation delete.DeleteDNSEntry has not yet been implemented")
 		})
 	}
+	if api.DeleteDeleteOverrideHandler == nil {
+		api.DeleteDeleteOverrideHandler = delete.DeleteOverrideHandlerFunc(func(params delete.DeleteOverrideParams) middleware.Responder {
+			return middleware.NotImplemented("operation delete.DeleteOverride has not yet been implemented")
+		})
+	}
+	if api.DeleteDeleteViewHandler == nil {
+		api.DeleteDeleteViewHandler = delete.DeleteViewHandlerFunc(func(params delete.DeleteViewParams) middleware.Responder {
+			return middleware.NotImplemented("operation delete.DeleteView has not yet been implemented")
+		})
+	}
+	if api.DeleteDeleteViewDNSEntryHandler == nil {
+		api.DeleteDeleteViewDNSEntryHandler = delete.DeleteViewDNSEntryHandlerFunc(func(params delete.DeleteViewDNSEntryParams) middleware.Responder {
+			return middleware.NotImplemented("operation delete.DeleteViewDNSEntry has not yet been implemented")
+		})
+	}
+	if api.ShowExportDkimKeyHandler == nil {
+		api.ShowExportDkimKeyHandler = show.ExportDkimKeyHandlerFunc(func(params show.ExportDkimKeyParams) middleware.Responder {
+			return middleware.NotImplemented("operation show.ExportDkimKey has not yet been implemented")
+		})
+	}
+	if api.ShowListOneACLHandler == nil {
+		api.ShowListOneACLHandler = show.ListOneACLHandlerFunc(func(params show.ListOneACLParams) middleware.Responder {
+			return middleware.NotImplemented("operation show.ListOneACL has not yet been implemented")
+		})
+	}
+	if api.ShowListOneBlocklistHandler == nil {
+		api.ShowListOneBlocklistHandler = show.ListOneBlocklistHandlerFunc(func(params show.ListOneBlocklistParams) middleware.Responder {
+			return middleware.NotImplemented("operation show.ListOneBlocklist has not yet been implemented")
+		})
+	}
 	if api.ShowListOneDNSEntryHandler == nil {
 		api.ShowListOneDNSEntryHandler = show.ListOneDNSEntryHandlerFunc(func(params show.ListOneDNSEntryParams) middleware.Responder {
 			return middleware.NotImplemented("operation show.ListOneDNSEntry has not yet been implemented")
 		})
 	}
+	if api.ShowListOneHealthHandler == nil {
+		api.ShowListOneHealthHandler = show.ListOneHealthHandlerFunc(func(params show.ListOneHealthParams) middleware.Responder {
+			return middleware.NotImplemented("operation show.ListOneHealth has not yet been implemented")
+		})
+	}
+	if api.ShowListOneOverrideHandler == nil {
+		api.ShowListOneOverrideHandler = show.ListOneOverrideHandlerFunc(func(params show.ListOneOverrideParams) middleware.Responder {
+			return middleware.NotImplemented("operation show.ListOneOverride has not yet been implemented")
+		})
+	}
+	if api.ShowListOneViewHandler == nil {
+		api.ShowListOneViewHandler = show.ListOneViewHandlerFunc(func(params show.ListOneViewParams) middleware.Responder {
+			return middleware.NotImplemented("operation show.ListOneView has not yet been implemented")
+		})
+	}
+	if api.ShowListOneViewDNSEntryHandler == nil {
+		api.ShowListOneViewDNSEntryHandler = show.ListOneViewDNSEntryHandlerFunc(func(params show.ListOneViewDNSEntryParams) middleware.Responder {
+			return middleware.NotImplemented("operation show.ListOneViewDNSEntry has not yet been implemented")
+		})
+	}
+	if api.ShowPreviewDNSEntryHandler == nil {
+		api.ShowPreviewDNSEntryHandler = show.PreviewDNSEntryHandlerFunc(func(params show.PreviewDNSEntryParams) middleware.Responder {
+			return middleware.NotImplemented("operation show.PreviewDNSEntry has not yet been implemented")
+		})
+	}
+	if api.DeleteRevokeDkimKeyHandler == nil {
+		api.DeleteRevokeDkimKeyHandler = delete.RevokeDkimKeyHandlerFunc(func(params delete.RevokeDkimKeyParams) middleware.Responder {
+			return middleware.NotImplemented("operation delete.RevokeDkimKey has not yet been implemented")
+		})
+	}
+	if api.UpdateRotateDkimKeyHandler == nil {
+		api.UpdateRotateDkimKeyHandler = update.RotateDkimKeyHandlerFunc(func(params update.RotateDkimKeyParams) middleware.Responder {
+			return middleware.NotImplemented("operation update.RotateDkimKey has not yet been implemented")
+		})
+	}
+	if api.ListShowAclsHandler == nil {
+		api.ListShowAclsHandler = list.ShowAclsHandlerFunc(func(params list.ShowAclsParams) middleware.Responder {
+			return middleware.NotImplemented("operation list.ShowAcls has not yet been implemented")
+		})
+	}
+	if api.ListShowBlocklistsHandler == nil {
+		api.ListShowBlocklistsHandler = list.ShowBlocklistsHandlerFunc(func(params list.ShowBlocklistsParams) middleware.Responder {
+			return middleware.NotImplemented("operation list.ShowBlocklists has not yet been implemented")
+		})
+	}
+	if api.ListShowDkimKeysHandler == nil {
+		api.ListShowDkimKeysHandler = list.ShowDkimKeysHandlerFunc(func(params list.ShowDkimKeysParams) middleware.Responder {
+			return middleware.NotImplemented("operation list.ShowDkimKeys has not yet been implemented")
+		})
+	}
 	if api.ListShowDNSRecordsHandler == nil {
 		api.ListShowDNSRecordsHandler = list.ShowDNSRecordsHandlerFunc(func(params list.ShowDNSRecordsParams) middleware.Responder {
 			return middleware.NotImplemented("operation list.ShowDNSRecords has not yet been implemented")
 		})
 	}
+	if api.ListShowHealthHandler == nil {
+		api.ListShowHealthHandler = list.ShowHealthHandlerFunc(func(params list.ShowHealthParams) middleware.Responder {
+			return middleware.NotImplemented("operation list.ShowHealth has not yet been implemented")
+		})
+	}
+	if api.ShowShowMailPolicyHandler == nil {
+		api.ShowShowMailPolicyHandler = show.ShowMailPolicyHandlerFunc(func(params show.ShowMailPolicyParams) middleware.Responder {
+			return middleware.NotImplemented("operation show.ShowMailPolicy has not yet been implemented")
+		})
+	}
+	if api.ListShowOverridesHandler == nil {
+		api.ListShowOverridesHandler = list.ShowOverridesHandlerFunc(func(params list.ShowOverridesParams) middleware.Responder {
+			return middleware.NotImplemented("operation list.ShowOverrides has not yet been implemented")
+		})
+	}
+	if api.ShowShowRrlHandler == nil {
+		api.ShowShowRrlHandler = show.ShowRrlHandlerFunc(func(params show.ShowRrlParams) middleware.Responder {
+			return middleware.NotImplemented("operation show.ShowRrl has not yet been implemented")
+		})
+	}
+	if api.ListShowViewDNSRecordsHandler == nil {
+		api.ListShowViewDNSRecordsHandler = list.ShowViewDNSRecordsHandlerFunc(func(params list.ShowViewDNSRecordsParams) middleware.Responder {
+			return middleware.NotImplemented("operation list.ShowViewDNSRecords has not yet been implemented")
+		})
+	}
+	if api.ListShowViewsHandler == nil {
+		api.ListShowViewsHandler = list.ShowViewsHandlerFunc(func(params list.ShowViewsParams) middleware.Responder {
+			return middleware.NotImplemented("operation list.ShowViews has not yet been implemented")
+		})
+	}
 	if api.UpdateUpdateDNSEntryHandler == nil {
 		api.UpdateUpdateDNSEntryHandler = update.UpdateDNSEntryHandlerFunc(func(params update.UpdateDNSEntryParams) middleware.Responder {
 			return middleware.NotImplemented("operation update.UpdateDNSEntry has not yet been implemented")
 		})
 	}
+	if api.UpdateUpdateMailPolicyHandler == nil {
+		api.UpdateUpdateMailPolicyHandler = update.UpdateMailPolicyHandlerFunc(func(params update.UpdateMailPolicyParams) middleware.Responder {
+			return middleware.NotImplemented("operation update.UpdateMailPolicy has not yet been implemented")
+		})
+	}
+	if api.UpdateUpdateRrlHandler == nil {
+		api.UpdateUpdateRrlHandler = update.UpdateRrlHandlerFunc(func(params update.UpdateRrlParams) middleware.Responder {
+			return middleware.NotImplemented("operation update.UpdateRrl has not yet been implemented")
+		})
+	}
+	if api.UpdateUpdateViewDNSEntryHandler == nil {
+		api.UpdateUpdateViewDNSEntryHandler = update.UpdateViewDNSEntryHandlerFunc(func(params update.UpdateViewDNSEntryParams) middleware.Responder {
+			return middleware.NotImplemented("operation update.UpdateViewDNSEntry has not yet been implemented")
+		})
+	}
 
 	api.PreServerShutdown = func() {}
 
@@ -33,18 +33,18 @@ func init() {
   "host": "localhost",
   "basePath": "/",
   "paths": {
-    "/dns": {
+    "/acl": {
       "get": {
         "tags": [
           "list"
         ],
-        "summary": "Show all dns records",
-        "operationId": "show_dns_records",
+        "summary": "Show all access control lists",
+        "operationId": "show_acls",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/dns_records"
+              "$ref": "#/definitions/acls"
             }
           },
           "400": {
@@ -55,7 +55,7 @@ func init() {
           }
         }
       },
-      "put": {
+      "post": {
         "consumes": [
           "application/json; charset=utf-8"
         ],
@@ -63,17 +63,17 @@ func init() {
           "application/json; charset=utf-8"
         ],
         "tags": [
-          "update"
+          "add"
         ],
-        "summary": "Update dns entry",
-        "operationId": "update_dns_entry",
+        "summary": "Add or replace access control list",
+        "operationId": "add_acl",
         "parameters": [
           {
-            "name": "update",
+            "name": "add",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/dns_entry"
+              "$ref": "#/definitions/acl"
             }
           }
         ],
@@ -81,7 +81,92 @@ func init() {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/dns_entry"
+              "$ref": "#/definitions/acl"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "delete"
+        ],
+        "summary": "Delete access control list",
+        "operationId": "delete_acl",
+        "parameters": [
+          {
+            "name": "delete",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/acl"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      }
+    },
+    "/acl/{name}": {
+      "get": {
+        "tags": [
+          "show"
+        ],
+        "summary": "List one access control list",
+        "operationId": "list_one_acl",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/acl"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      }
+    },
+    "/blocklist": {
+      "get": {
+        "tags": [
+          "list"
+        ],
+        "summary": "Show all block and allow lists with hit counts",
+        "operationId": "show_blocklists",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/blocklists"
             }
           },
           "400": {
@@ -102,15 +187,15 @@ func init() {
         "tags": [
           "add"
         ],
-        "summary": "Add dns entry",
-        "operationId": "add_dns_entry",
+        "summary": "Add or replace block list, the list is loaded immediately",
+        "operationId": "add_blocklist",
         "parameters": [
           {
             "name": "add",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/dns_entry"
+              "$ref": "#/definitions/blocklist"
             }
           }
         ],
@@ -118,7 +203,7 @@ func init() {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/dns_entry"
+              "$ref": "#/definitions/blocklist"
             }
           },
           "400": {
@@ -133,15 +218,15 @@ func init() {
         "tags": [
           "delete"
         ],
-        "summary": "Delete dns entry",
-        "operationId": "delete_dns_entry",
+        "summary": "Delete block list",
+        "operationId": "delete_blocklist",
         "parameters": [
           {
             "name": "delete",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/dns_entry"
+              "$ref": "#/definitions/blocklist"
             }
           }
         ],
@@ -161,17 +246,17 @@ func init() {
         }
       }
     },
-    "/dns/{domain}": {
+    "/blocklist/{name}": {
       "get": {
         "tags": [
           "show"
         ],
-        "summary": "List one dns entry",
-        "operationId": "list_one_dns_entry",
+        "summary": "List one block list",
+        "operationId": "list_one_blocklist",
         "parameters": [
           {
             "type": "string",
-            "name": "domain",
+            "name": "name",
             "in": "path",
             "required": true
           }
@@ -180,7 +265,7 @@ func init() {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/dns_entry"
+              "$ref": "#/definitions/blocklist"
             }
           },
           "400": {
@@ -191,77 +276,7 @@ func init() {
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "answer": {
-      "type": "object",
-      "properties": {
-        "Code": {
-          "type": "integer",
-          "format": "uint32"
-        },
-        "Message": {
-          "type": "string"
-        }
-      }
-    },
-    "dns_entry": {
-      "type": "object",
-      "properties": {
-        "acme": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "dkim_private_key": {
-          "type": "string"
-        },
-        "dkim_public_key": {
-          "type": "string"
-        },
-        "domain": {
-          "type": "string"
-        },
-        "ips": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "ipv4": {
-          "type": "string"
-        },
-        "ipv6": {
-          "type": "string"
-        }
-      }
-    },
-    "dns_records": {
-      "type": "object",
-      "additionalProperties": {
-        "$ref": "#/definitions/dns_entry"
-      }
-    }
-  }
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "schemes": [
-    "http"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "Rest API for mDNS Server",
-    "title": "mDNS API",
-    "contact": {
-      "email": "cryptocoin62@gmail.com"
     },
-    "version": "1.0.0"
-  },
-  "host": "localhost",
-  "basePath": "/",
-  "paths": {
     "/dns": {
       "get": {
         "tags": [
@@ -420,57 +435,4076 @@ func init() {
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "answer": {
-      "type": "object",
-      "properties": {
-        "Code": {
-          "type": "integer",
-          "format": "uint32"
-        },
-        "Message": {
-          "type": "string"
-        }
-      }
     },
-    "dns_entry": {
-      "type": "object",
-      "properties": {
-        "acme": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/dns/{domain}/dkim": {
+      "get": {
+        "tags": [
+          "list"
+        ],
+        "summary": "Show DKIM keys of dns entry without private keys",
+        "operationId": "show_dkim_keys",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "name of view of zone, default zones without",
+            "name": "view",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "domain",
+            "in": "path",
+            "required": true
           }
-        },
-        "dkim_private_key": {
-          "type": "string"
-        },
-        "dkim_public_key": {
-          "type": "string"
-        },
-        "domain": {
-          "type": "string"
-        },
-        "ips": {
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/dkim_keys"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
           }
-        },
-        "ipv4": {
-          "type": "string"
-        },
-        "ipv6": {
-          "type": "string"
         }
-      }
-    },
-    "dns_records": {
-      "type": "object",
-      "additionalProperties": {
-        "$ref": "#/definitions/dns_entry"
+      },
+      "put": {
+        "consumes": [
+          "application/json; charset=utf-8"
+        ],
+        "produces": [
+          "application/json; charset=utf-8"
+        ],
+        "tags": [
+          "update"
+        ],
+        "summary": "Rotate DKIM key, new selector is published first and signs after publish delay",
+        "operationId": "rotate_dkim_key",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "name of view of zone, default zones without",
+            "name": "view",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "domain",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "optional selector and algorithm of new key",
+            "name": "rotate",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/dkim_key"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/dkim_key"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      },
+      "post": {
+        "consumes": [
+          "application/json; charset=utf-8"
+        ],
+        "produces": [
+          "application/json; charset=utf-8"
+        ],
+        "tags": [
+          "add"
+        ],
+        "summary": "Add DKIM key with selector, it signs at once without active key, otherwise after publish delay",
+        "operationId": "add_dkim_key",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "name of view of zone, default zones without",
+            "name": "view",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "domain",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "add",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/dkim_key"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/dkim_key"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "delete"
+        ],
+        "summary": "Revoke DKIM key, its selector is published with empty key until retire period ends",
+        "operationId": "revoke_dkim_key",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "name of view of zone, default zones without",
+            "name": "view",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "domain",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "revoke",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/dkim_key"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      }
+    },
+    "/dns/{domain}/dkim/{selector}/pem": {
+      "get": {
+        "tags": [
+          "show"
+        ],
+        "summary": "Export private DKIM key in PEM format",
+        "operationId": "export_dkim_key",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "name of view of zone, default zones without",
+            "name": "view",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "domain",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "selector",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/dkim_pem"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      }
+    },
+    "/dns/{domain}/mail": {
+      "get": {
+        "tags": [
+          "show"
+        ],
+        "summary": "Show mail policy of dns entry",
+        "operationId": "show_mail_policy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "domain",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/mail_policy"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      },
+      "put": {
+        "consumes": [
+          "application/json; charset=utf-8"
+        ],
+        "produces": [
+          "application/json; charset=utf-8"
+        ],
+        "tags": [
+          "update"
+        ],
+        "summary": "Update mail policy of dns entry, SPF and DMARC records are generated from it",
+        "operationId": "update_mail_policy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "domain",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "update",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/mail_policy"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/mail_policy"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      }
+    },
+    "/dns/{domain}/preview": {
+      "get": {
+        "tags": [
+          "show"
+        ],
+        "summary": "Preview addresses a client would receive for dns entry",
+        "operationId": "preview_dns_entry",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "domain",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "address or network in CIDR notation of client",
+            "name": "ip",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/preview"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      }
+    },
+    "/health": {
+      "get": {
+        "tags": [
+          "list"
+        ],
+        "summary": "Show status of health checks of all dns entries",
+        "operationId": "show_health",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/health_statuses"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      }
+    },
+    "/health/{domain}": {
+      "get": {
+        "tags": [
+          "show"
+        ],
+        "summary": "List status of health checks of one dns entry",
+        "operationId": "list_one_health",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "domain",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/health_status_list"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      }
+    },
+    "/override": {
+      "get": {
+        "tags": [
+          "list"
+        ],
+        "summary": "Show all static overrides of names",
+        "operationId": "show_overrides",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/overrides"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      },
+      "post": {
+        "consumes": [
+          "application/json; charset=utf-8"
+        ],
+        "produces": [
+          "application/json; charset=utf-8"
+        ],
+        "tags": [
+          "add"
+        ],
+        "summary": "Add or replace static override of exact name",
+        "operationId": "add_override",
+        "parameters": [
+          {
+            "name": "add",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/override"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/override"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "delete"
+        ],
+        "summary": "Delete static override",
+        "operationId": "delete_override",
+        "parameters": [
+          {
+            "name": "delete",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/override"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      }
+    },
+    "/override/{name}": {
+      "get": {
+        "tags": [
+          "show"
+        ],
+        "summary": "List one static override",
+        "operationId": "list_one_override",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/override"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      }
+    },
+    "/rrl": {
+      "get": {
+        "tags": [
+          "show"
+        ],
+        "summary": "Show settings and counters of response rate limiting",
+        "operationId": "show_rrl",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/rrl"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      },
+      "put": {
+        "consumes": [
+          "application/json; charset=utf-8"
+        ],
+        "produces": [
+          "application/json; charset=utf-8"
+        ],
+        "tags": [
+          "update"
+        ],
+        "summary": "Update settings of response rate limiting, omitted settings are kept",
+        "operationId": "update_rrl",
+        "parameters": [
+          {
+            "name": "update",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/rrl_update"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/rrl"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      }
+    },
+    "/views": {
+      "get": {
+        "tags": [
+          "list"
+        ],
+        "summary": "Show all views",
+        "operationId": "show_views",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/views"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      },
+      "post": {
+        "consumes": [
+          "application/json; charset=utf-8"
+        ],
+        "produces": [
+          "application/json; charset=utf-8"
+        ],
+        "tags": [
+          "add"
+        ],
+        "summary": "Add or replace view, zones of replaced view are kept",
+        "operationId": "add_view",
+        "parameters": [
+          {
+            "name": "add",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/view"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/view"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "delete"
+        ],
+        "summary": "Delete view with its zones",
+        "operationId": "delete_view",
+        "parameters": [
+          {
+            "name": "delete",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/view"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      }
+    },
+    "/views/{view}": {
+      "get": {
+        "tags": [
+          "show"
+        ],
+        "summary": "List one view",
+        "operationId": "list_one_view",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "view",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/view"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      }
+    },
+    "/views/{view}/dns": {
+      "get": {
+        "tags": [
+          "list"
+        ],
+        "summary": "Show all dns records of view",
+        "operationId": "show_view_dns_records",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "view",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/dns_records"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      },
+      "put": {
+        "consumes": [
+          "application/json; charset=utf-8"
+        ],
+        "produces": [
+          "application/json; charset=utf-8"
+        ],
+        "tags": [
+          "update"
+        ],
+        "summary": "Update dns entry of view",
+        "operationId": "update_view_dns_entry",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "view",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "update",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/dns_entry"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/dns_entry"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      },
+      "post": {
+        "consumes": [
+          "application/json; charset=utf-8"
+        ],
+        "produces": [
+          "application/json; charset=utf-8"
+        ],
+        "tags": [
+          "add"
+        ],
+        "summary": "Add dns entry to view",
+        "operationId": "add_view_dns_entry",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "view",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "add",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/dns_entry"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/dns_entry"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "delete"
+        ],
+        "summary": "Delete dns entry of view",
+        "operationId": "delete_view_dns_entry",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "view",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "delete",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/dns_entry"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      }
+    },
+    "/views/{view}/dns/{domain}": {
+      "get": {
+        "tags": [
+          "show"
+        ],
+        "summary": "List one dns entry of view",
+        "operationId": "list_one_view_dns_entry",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "view",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "domain",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/dns_entry"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "acl": {
+      "type": "object",
+      "properties": {
+        "elements": {
+          "description": "networks, addresses, key:\u003ctsig key\u003e, names of other lists, ! negates",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "acls": {
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/acl"
+      }
+    },
+    "answer": {
+      "type": "object",
+      "properties": {
+        "Code": {
+          "type": "integer",
+          "format": "uint32"
+        },
+        "Message": {
+          "type": "string"
+        }
+      }
+    },
+    "balance": {
+      "description": "selection of answered addresses among healthy ones",
+      "type": "object",
+      "properties": {
+        "count": {
+          "description": "number of addresses of weighted policy, 1 by default",
+          "type": "integer"
+        },
+        "policy": {
+          "description": "all in stored order, round_robin rotates, weighted picks count addresses at random by weight, first_healthy answers the first one",
+          "type": "string",
+          "enum": [
+            "all",
+            "round_robin",
+            "weighted",
+            "first_healthy"
+          ]
+        },
+        "weights": {
+          "description": "weights of addresses for weighted policy, 1 by default, 0 drains address",
+          "type": "object",
+          "additionalProperties": {
+            "type": "integer"
+          }
+        }
+      }
+    },
+    "bimi_policy": {
+      "description": "BIMI record of zone published at default._bimi, receivers show the logo only with DMARC policy quarantine or reject",
+      "type": "object",
+      "properties": {
+        "authority": {
+          "description": "https URL of verified mark certificate in PEM format",
+          "type": "string"
+        },
+        "location": {
+          "description": "https URL of logo in SVG Tiny PS format",
+          "type": "string"
+        }
+      }
+    },
+    "blocklist": {
+      "type": "object",
+      "properties": {
+        "allow": {
+          "description": "names of the list are never blocked",
+          "type": "boolean"
+        },
+        "entries": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "format": {
+          "type": "string",
+          "enum": [
+            "hosts",
+            "domains",
+            "adblock"
+          ]
+        },
+        "groups": {
+          "description": "access lists of clients the list applies to, empty for all clients",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "hits": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "name": {
+          "type": "string"
+        },
+        "order": {
+          "description": "lists are checked in ascending order, hits count for the first matching block list",
+          "type": "integer"
+        },
+        "source": {
+          "description": "path to local file or http(s) url",
+          "type": "string"
+        },
+        "updated": {
+          "type": "string",
+          "readOnly": true
+        }
+      }
+    },
+    "blocklists": {
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/blocklist"
+      }
+    },
+    "delegation": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "description": "child zone, absolute or relative to zone like sub",
+          "type": "string"
+        },
+        "name_servers": {
+          "description": "NS records of child zone, name servers below child zone need addresses",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/name_server"
+          }
+        }
+      }
+    },
+    "dkim_key": {
+      "type": "object",
+      "properties": {
+        "activated": {
+          "description": "time of start of signing in RFC 3339 format",
+          "type": "string"
+        },
+        "algorithm": {
+          "description": "rsa-2048 by default",
+          "type": "string",
+          "enum": [
+            "rsa-2048",
+            "rsa-4096",
+            "ed25519"
+          ]
+        },
+        "created": {
+          "description": "time of publishing in RFC 3339 format",
+          "type": "string"
+        },
+        "private_key": {
+          "description": "base64 private key, PKCS1 for rsa, PKCS8 for ed25519",
+          "type": "string"
+        },
+        "public_key": {
+          "description": "base64 public key of DKIM record, SubjectPublicKeyInfo for rsa, raw key for ed25519",
+          "type": "string"
+        },
+        "retired": {
+          "description": "time of end of signing in RFC 3339 format",
+          "type": "string"
+        },
+        "revoked": {
+          "description": "time of revocation in RFC 3339 format",
+          "type": "string"
+        },
+        "selector": {
+          "description": "published at \u003cselector\u003e._domainkey of zone",
+          "type": "string"
+        },
+        "state": {
+          "description": "published waits for activation, active signs, retired and revoked stay published until retire period ends",
+          "type": "string",
+          "enum": [
+            "published",
+            "active",
+            "retired",
+            "revoked"
+          ]
+        }
+      }
+    },
+    "dkim_keys": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/dkim_key"
+      }
+    },
+    "dkim_pem": {
+      "type": "object",
+      "properties": {
+        "algorithm": {
+          "type": "string"
+        },
+        "pem": {
+          "description": "private key in PEM format for MTAs",
+          "type": "string"
+        },
+        "selector": {
+          "type": "string"
+        }
+      }
+    },
+    "dmarc_policy": {
+      "description": "DMARC record of zone (RFC 7489)",
+      "type": "object",
+      "properties": {
+        "adkim": {
+          "description": "DKIM alignment, relaxed by default",
+          "type": "string",
+          "enum": [
+            "relaxed",
+            "strict"
+          ]
+        },
+        "aspf": {
+          "description": "SPF alignment, relaxed by default",
+          "type": "string",
+          "enum": [
+            "relaxed",
+            "strict"
+          ]
+        },
+        "pct": {
+          "description": "percentage of messages the policy applies to, 100 by default",
+          "type": "integer",
+          "maximum": 100,
+          "x-nullable": true
+        },
+        "policy": {
+          "description": "policy of zone, none by default",
+          "type": "string",
+          "enum": [
+            "none",
+            "quarantine",
+            "reject"
+          ]
+        },
+        "rua": {
+          "description": "addresses of aggregate reports, mailto URIs or plain addresses",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "ruf": {
+          "description": "addresses of failure reports, mailto URIs or plain addresses",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "subdomain_policy": {
+          "description": "policy of subdomains, policy of zone by default",
+          "type": "string",
+          "enum": [
+            "none",
+            "quarantine",
+            "reject"
+          ]
+        }
+      }
+    },
+    "dns_entry": {
+      "type": "object",
+      "properties": {
+        "acme": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "auto_ptr": {
+          "description": "reverse zone answers PTR queries with names of entries which have the queried address",
+          "type": "boolean"
+        },
+        "balance": {
+          "$ref": "#/definitions/balance"
+        },
+        "delegations": {
+          "description": "child zones on other name servers, queries at or below them are answered with referrals",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/delegation"
+          }
+        },
+        "dkim_keys": {
+          "description": "DKIM keys of zone, a key of selector mail is generated with zone",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/dkim_key"
+          }
+        },
+        "dkim_private_key": {
+          "description": "private key of active DKIM key, see dkim_keys",
+          "type": "string"
+        },
+        "dkim_public_key": {
+          "description": "public key of active DKIM key, see dkim_keys",
+          "type": "string"
+        },
+        "domain": {
+          "type": "string"
+        },
+        "geo": {
+          "description": "addresses for clients by location of GeoIP database, asn before country before continent, ipv4s and ipv6s are the default",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/geo_records"
+          }
+        },
+        "health_check": {
+          "$ref": "#/definitions/health_check"
+        },
+        "ipv4s": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "ipv6_synthesis": {
+          "description": "AAAA records for ipv4s without ipv6s, none by default, mapped as ::ffff:a.b.c.d, nat64 under nat64_prefix",
+          "type": "string",
+          "enum": [
+            "none",
+            "mapped",
+            "nat64"
+          ]
+        },
+        "ipv6s": {
+          "description": "ipv6 addresses, AAAA records are synthesized from ipv4s only without them and with ipv6_synthesis",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "mail": {
+          "$ref": "#/definitions/mail_policy"
+        },
+        "name_servers": {
+          "description": "NS records of zone with addresses of name servers, ns1 and ns2 of zone by default",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/name_server"
+          }
+        },
+        "nat64_prefix": {
+          "description": "prefix of nat64 synthesis of length 32, 40, 48, 56, 64 or 96 (RFC 6052), 64:ff9b::/96 by default",
+          "type": "string"
+        },
+        "ptrs": {
+          "description": "records of reverse zone, CNAMEs of parent zone and PTRs of child zone for classless delegation (RFC 2317)",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ptr_record"
+          }
+        },
+        "srvs": {
+          "description": "SRV records of services in zone, addresses of targets in zone are sent in additional section",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/srv_record"
+          }
+        },
+        "subnets": {
+          "description": "addresses for clients in networks, longest prefix wins, client subnet of EDNS is used when present",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/subnet_records"
+          }
+        },
+        "txts": {
+          "description": "TXT records matched by exact owner name, an SPF or DMARC record replaces the generated one",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/txt_record"
+          }
+        }
+      }
+    },
+    "dns_records": {
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/dns_entry"
+      }
+    },
+    "geo_records": {
+      "type": "object",
+      "properties": {
+        "asns": {
+          "description": "autonomous system numbers",
+          "type": "array",
+          "items": {
+            "type": "integer"
+          }
+        },
+        "continents": {
+          "description": "continent codes like EU",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "countries": {
+          "description": "ISO 3166 country codes like DE",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "ipv4s": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "ipv6s": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "health_check": {
+      "description": "check of every address of dns entry, only healthy addresses are answered",
+      "type": "object",
+      "properties": {
+        "all_down": {
+          "description": "answer with all addresses or with none when every address is down",
+          "type": "string",
+          "enum": [
+            "all",
+            "none"
+          ]
+        },
+        "expect_status": {
+          "description": "status of http response, 200 by default",
+          "type": "integer"
+        },
+        "fall": {
+          "description": "failed checks until address is down, 3 by default",
+          "type": "integer"
+        },
+        "host": {
+          "description": "host header and server name of http request, domain by default",
+          "type": "string"
+        },
+        "interval": {
+          "description": "seconds between checks, 10 by default",
+          "type": "integer"
+        },
+        "path": {
+          "description": "path of http request",
+          "type": "string"
+        },
+        "port": {
+          "description": "port of check, 80 for http, 443 for https, 53 for dns by default",
+          "type": "integer"
+        },
+        "query_name": {
+          "description": "name of dns query, domain by default",
+          "type": "string"
+        },
+        "query_type": {
+          "description": "type of dns query, A by default",
+          "type": "string"
+        },
+        "rise": {
+          "description": "successful checks until address is healthy again, 2 by default",
+          "type": "integer"
+        },
+        "timeout": {
+          "description": "seconds until check fails, 2 by default",
+          "type": "integer"
+        },
+        "type": {
+          "description": "tcp connects, ping accepts refused connections too, http and https expect status, dns expects NOERROR",
+          "type": "string",
+          "enum": [
+            "tcp",
+            "ping",
+            "http",
+            "https",
+            "dns"
+          ]
+        }
+      }
+    },
+    "health_status": {
+      "type": "object",
+      "properties": {
+        "address": {
+          "type": "string"
+        },
+        "checked": {
+          "description": "time of last check",
+          "type": "string",
+          "format": "date-time"
+        },
+        "error": {
+          "description": "error of last failed check",
+          "type": "string"
+        },
+        "failures": {
+          "description": "failed checks in a row",
+          "type": "integer"
+        },
+        "healthy": {
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "successes": {
+          "description": "successful checks in a row",
+          "type": "integer"
+        }
+      }
+    },
+    "health_status_list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/health_status"
+      }
+    },
+    "health_statuses": {
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/health_status_list"
+      }
+    },
+    "mail_policy": {
+      "description": "mail policy of zone, kept by updates of dns entry without it",
+      "type": "object",
+      "properties": {
+        "bimi": {
+          "$ref": "#/definitions/bimi_policy"
+        },
+        "dmarc": {
+          "$ref": "#/definitions/dmarc_policy"
+        },
+        "mta_sts": {
+          "$ref": "#/definitions/mta_sts_policy"
+        },
+        "spf": {
+          "$ref": "#/definitions/spf_policy"
+        },
+        "tls_rpt": {
+          "$ref": "#/definitions/tls_rpt_policy"
+        }
+      }
+    },
+    "mta_sts_policy": {
+      "description": "MTA-STS policy of zone (RFC 8461), published at _mta-sts and served as mta-sts.txt over https by mdns",
+      "type": "object",
+      "properties": {
+        "max_age": {
+          "description": "seconds senders cache the policy, one week by default",
+          "type": "integer",
+          "maximum": 31557600
+        },
+        "mode": {
+          "description": "mode of policy, testing by default",
+          "type": "string",
+          "enum": [
+            "enforce",
+            "testing",
+            "none"
+          ]
+        },
+        "mx": {
+          "description": "names of mail servers, a leading *. matches one label, MX of zone by default",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "name_server": {
+      "type": "object",
+      "properties": {
+        "ipv4s": {
+          "description": "addresses of name server below zone, sent as glue",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "ipv6s": {
+          "description": "addresses of name server below zone, sent as glue",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "description": "host name of name server, absolute or relative to zone like ns1",
+          "type": "string"
+        }
+      }
+    },
+    "override": {
+      "type": "object",
+      "properties": {
+        "ipv4s": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "ipv6s": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "overrides": {
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/override"
+      }
+    },
+    "preview": {
+      "type": "object",
+      "properties": {
+        "asn": {
+          "type": "integer"
+        },
+        "continent": {
+          "type": "string"
+        },
+        "country": {
+          "type": "string"
+        },
+        "domain": {
+          "type": "string"
+        },
+        "ip": {
+          "type": "string"
+        },
+        "ipv4s": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "ipv6s": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "ptr_record": {
+      "type": "object",
+      "properties": {
+        "cname": {
+          "description": "name in zone of classless delegation instead of ptr",
+          "type": "string"
+        },
+        "name": {
+          "description": "owner name, absolute or relative to reverse zone like 1 or 1.0-25",
+          "type": "string"
+        },
+        "ptr": {
+          "description": "host name of address",
+          "type": "string"
+        }
+      }
+    },
+    "rrl": {
+      "type": "object",
+      "properties": {
+        "dropped": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "errors_per_second": {
+          "description": "error answers per second for one client prefix, 0 takes responses_per_second",
+          "type": "integer",
+          "format": "int64"
+        },
+        "exempt": {
+          "description": "access lists of clients which are never limited",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "exempted": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "ipv4_prefix_length": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "ipv6_prefix_length": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "nxdomains_per_second": {
+          "description": "nxdomain answers per second for one client prefix and zone, 0 takes responses_per_second",
+          "type": "integer",
+          "format": "int64"
+        },
+        "responses": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "responses_per_second": {
+          "description": "answers and empty answers per second for one client prefix and name, 0 disables limiting",
+          "type": "integer",
+          "format": "int64"
+        },
+        "slip": {
+          "description": "every slip limited answer is sent truncated instead of dropped, 0 drops all",
+          "type": "integer",
+          "format": "int64"
+        },
+        "slipped": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "window": {
+          "description": "seconds of over limit answers which are remembered",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "rrl_update": {
+      "description": "settings of response rate limiting, omitted settings are kept",
+      "type": "object",
+      "properties": {
+        "errors_per_second": {
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "exempt": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-nullable": true
+        },
+        "ipv4_prefix_length": {
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "ipv6_prefix_length": {
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "nxdomains_per_second": {
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "responses_per_second": {
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "slip": {
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "window": {
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        }
+      }
+    },
+    "spf_policy": {
+      "description": "SPF record of zone (RFC 7208), without mechanisms and includes the addresses of zone, a and mx are allowed",
+      "type": "object",
+      "properties": {
+        "includes": {
+          "description": "domains of include mechanisms like _spf.google.com",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "mechanisms": {
+          "description": "mechanisms and modifiers before all like a, mx, ip4:192.0.2.0/24, ip6:2001:db8::/32, exists:%{i}.example.com",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "qualifier": {
+          "description": "qualifier of all mechanism, softfail by default",
+          "type": "string",
+          "enum": [
+            "fail",
+            "softfail",
+            "neutral"
+          ]
+        }
+      }
+    },
+    "srv_record": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "description": "owner name of service, absolute or relative to zone like _sip._tcp",
+          "type": "string"
+        },
+        "port": {
+          "type": "integer"
+        },
+        "priority": {
+          "type": "integer",
+          "x-omitempty": false
+        },
+        "target": {
+          "description": "host name of service, absolute or relative to zone, . when service is not available",
+          "type": "string"
+        },
+        "weight": {
+          "type": "integer",
+          "x-omitempty": false
+        }
+      }
+    },
+    "subnet_records": {
+      "type": "object",
+      "properties": {
+        "ipv4s": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "ipv6s": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "subnet": {
+          "description": "network in CIDR notation",
+          "type": "string"
+        }
+      }
+    },
+    "tls_rpt_policy": {
+      "description": "SMTP TLS reporting of zone (RFC 8460), published at _smtp._tls",
+      "type": "object",
+      "properties": {
+        "rua": {
+          "description": "destinations of reports, mailto or https URIs or plain addresses",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "txt_record": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "description": "owner name, absolute or relative to zone like _github-challenge, @ for zone",
+          "type": "string"
+        },
+        "values": {
+          "description": "strings of one TXT record, strings over 255 bytes are split",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "view": {
+      "type": "object",
+      "properties": {
+        "clients": {
+          "description": "networks, addresses, key:\u003ctsig key\u003e, names of access lists, ! negates, any client when empty",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "listeners": {
+          "description": "local addresses \"ip\" or \"ip:port\" of listeners, which servers are bound to by LISTEN, any listener when empty",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "order": {
+          "description": "views are matched in ascending order, the first matching view answers with its zones",
+          "type": "integer"
+        }
+      }
+    },
+    "views": {
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/view"
+      }
+    }
+  }
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "schemes": [
+    "http"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "Rest API for mDNS Server",
+    "title": "mDNS API",
+    "contact": {
+      "email": "cryptocoin62@gmail.com"
+    },
+    "version": "1.0.0"
+  },
+  "host": "localhost",
+  "basePath": "/",
+  "paths": {
+    "/acl": {
+      "get": {
+        "tags": [
+          "list"
+        ],
+        "summary": "Show all access control lists",
+        "operationId": "show_acls",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/acls"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      },
+      "post": {
+        "consumes": [
+          "application/json; charset=utf-8"
+        ],
+        "produces": [
+          "application/json; charset=utf-8"
+        ],
+        "tags": [
+          "add"
+        ],
+        "summary": "Add or replace access control list",
+        "operationId": "add_acl",
+        "parameters": [
+          {
+            "name": "add",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/acl"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/acl"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "delete"
+        ],
+        "summary": "Delete access control list",
+        "operationId": "delete_acl",
+        "parameters": [
+          {
+            "name": "delete",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/acl"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      }
+    },
+    "/acl/{name}": {
+      "get": {
+        "tags": [
+          "show"
+        ],
+        "summary": "List one access control list",
+        "operationId": "list_one_acl",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/acl"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      }
+    },
+    "/blocklist": {
+      "get": {
+        "tags": [
+          "list"
+        ],
+        "summary": "Show all block and allow lists with hit counts",
+        "operationId": "show_blocklists",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/blocklists"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      },
+      "post": {
+        "consumes": [
+          "application/json; charset=utf-8"
+        ],
+        "produces": [
+          "application/json; charset=utf-8"
+        ],
+        "tags": [
+          "add"
+        ],
+        "summary": "Add or replace block list, the list is loaded immediately",
+        "operationId": "add_blocklist",
+        "parameters": [
+          {
+            "name": "add",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/blocklist"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/blocklist"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "delete"
+        ],
+        "summary": "Delete block list",
+        "operationId": "delete_blocklist",
+        "parameters": [
+          {
+            "name": "delete",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/blocklist"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      }
+    },
+    "/blocklist/{name}": {
+      "get": {
+        "tags": [
+          "show"
+        ],
+        "summary": "List one block list",
+        "operationId": "list_one_blocklist",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/blocklist"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      }
+    },
+    "/dns": {
+      "get": {
+        "tags": [
+          "list"
+        ],
+        "summary": "Show all dns records",
+        "operationId": "show_dns_records",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/dns_records"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      },
+      "put": {
+        "consumes": [
+          "application/json; charset=utf-8"
+        ],
+        "produces": [
+          "application/json; charset=utf-8"
+        ],
+        "tags": [
+          "update"
+        ],
+        "summary": "Update dns entry",
+        "operationId": "update_dns_entry",
+        "parameters": [
+          {
+            "name": "update",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/dns_entry"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/dns_entry"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      },
+      "post": {
+        "consumes": [
+          "application/json; charset=utf-8"
+        ],
+        "produces": [
+          "application/json; charset=utf-8"
+        ],
+        "tags": [
+          "add"
+        ],
+        "summary": "Add dns entry",
+        "operationId": "add_dns_entry",
+        "parameters": [
+          {
+            "name": "add",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/dns_entry"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/dns_entry"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "delete"
+        ],
+        "summary": "Delete dns entry",
+        "operationId": "delete_dns_entry",
+        "parameters": [
+          {
+            "name": "delete",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/dns_entry"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      }
+    },
+    "/dns/{domain}": {
+      "get": {
+        "tags": [
+          "show"
+        ],
+        "summary": "List one dns entry",
+        "operationId": "list_one_dns_entry",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "domain",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/dns_entry"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      }
+    },
+    "/dns/{domain}/dkim": {
+      "get": {
+        "tags": [
+          "list"
+        ],
+        "summary": "Show DKIM keys of dns entry without private keys",
+        "operationId": "show_dkim_keys",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "name of view of zone, default zones without",
+            "name": "view",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "domain",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/dkim_keys"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      },
+      "put": {
+        "consumes": [
+          "application/json; charset=utf-8"
+        ],
+        "produces": [
+          "application/json; charset=utf-8"
+        ],
+        "tags": [
+          "update"
+        ],
+        "summary": "Rotate DKIM key, new selector is published first and signs after publish delay",
+        "operationId": "rotate_dkim_key",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "name of view of zone, default zones without",
+            "name": "view",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "domain",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "optional selector and algorithm of new key",
+            "name": "rotate",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/dkim_key"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/dkim_key"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      },
+      "post": {
+        "consumes": [
+          "application/json; charset=utf-8"
+        ],
+        "produces": [
+          "application/json; charset=utf-8"
+        ],
+        "tags": [
+          "add"
+        ],
+        "summary": "Add DKIM key with selector, it signs at once without active key, otherwise after publish delay",
+        "operationId": "add_dkim_key",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "name of view of zone, default zones without",
+            "name": "view",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "domain",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "add",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/dkim_key"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/dkim_key"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "delete"
+        ],
+        "summary": "Revoke DKIM key, its selector is published with empty key until retire period ends",
+        "operationId": "revoke_dkim_key",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "name of view of zone, default zones without",
+            "name": "view",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "domain",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "revoke",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/dkim_key"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      }
+    },
+    "/dns/{domain}/dkim/{selector}/pem": {
+      "get": {
+        "tags": [
+          "show"
+        ],
+        "summary": "Export private DKIM key in PEM format",
+        "operationId": "export_dkim_key",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "name of view of zone, default zones without",
+            "name": "view",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "domain",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "selector",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/dkim_pem"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      }
+    },
+    "/dns/{domain}/mail": {
+      "get": {
+        "tags": [
+          "show"
+        ],
+        "summary": "Show mail policy of dns entry",
+        "operationId": "show_mail_policy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "domain",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/mail_policy"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      },
+      "put": {
+        "consumes": [
+          "application/json; charset=utf-8"
+        ],
+        "produces": [
+          "application/json; charset=utf-8"
+        ],
+        "tags": [
+          "update"
+        ],
+        "summary": "Update mail policy of dns entry, SPF and DMARC records are generated from it",
+        "operationId": "update_mail_policy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "domain",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "update",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/mail_policy"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/mail_policy"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      }
+    },
+    "/dns/{domain}/preview": {
+      "get": {
+        "tags": [
+          "show"
+        ],
+        "summary": "Preview addresses a client would receive for dns entry",
+        "operationId": "preview_dns_entry",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "domain",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "address or network in CIDR notation of client",
+            "name": "ip",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/preview"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      }
+    },
+    "/health": {
+      "get": {
+        "tags": [
+          "list"
+        ],
+        "summary": "Show status of health checks of all dns entries",
+        "operationId": "show_health",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/health_statuses"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      }
+    },
+    "/health/{domain}": {
+      "get": {
+        "tags": [
+          "show"
+        ],
+        "summary": "List status of health checks of one dns entry",
+        "operationId": "list_one_health",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "domain",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/health_status_list"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      }
+    },
+    "/override": {
+      "get": {
+        "tags": [
+          "list"
+        ],
+        "summary": "Show all static overrides of names",
+        "operationId": "show_overrides",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/overrides"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      },
+      "post": {
+        "consumes": [
+          "application/json; charset=utf-8"
+        ],
+        "produces": [
+          "application/json; charset=utf-8"
+        ],
+        "tags": [
+          "add"
+        ],
+        "summary": "Add or replace static override of exact name",
+        "operationId": "add_override",
+        "parameters": [
+          {
+            "name": "add",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/override"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/override"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "delete"
+        ],
+        "summary": "Delete static override",
+        "operationId": "delete_override",
+        "parameters": [
+          {
+            "name": "delete",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/override"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      }
+    },
+    "/override/{name}": {
+      "get": {
+        "tags": [
+          "show"
+        ],
+        "summary": "List one static override",
+        "operationId": "list_one_override",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/override"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      }
+    },
+    "/rrl": {
+      "get": {
+        "tags": [
+          "show"
+        ],
+        "summary": "Show settings and counters of response rate limiting",
+        "operationId": "show_rrl",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/rrl"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      },
+      "put": {
+        "consumes": [
+          "application/json; charset=utf-8"
+        ],
+        "produces": [
+          "application/json; charset=utf-8"
+        ],
+        "tags": [
+          "update"
+        ],
+        "summary": "Update settings of response rate limiting, omitted settings are kept",
+        "operationId": "update_rrl",
+        "parameters": [
+          {
+            "name": "update",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/rrl_update"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/rrl"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      }
+    },
+    "/views": {
+      "get": {
+        "tags": [
+          "list"
+        ],
+        "summary": "Show all views",
+        "operationId": "show_views",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/views"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      },
+      "post": {
+        "consumes": [
+          "application/json; charset=utf-8"
+        ],
+        "produces": [
+          "application/json; charset=utf-8"
+        ],
+        "tags": [
+          "add"
+        ],
+        "summary": "Add or replace view, zones of replaced view are kept",
+        "operationId": "add_view",
+        "parameters": [
+          {
+            "name": "add",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/view"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/view"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "delete"
+        ],
+        "summary": "Delete view with its zones",
+        "operationId": "delete_view",
+        "parameters": [
+          {
+            "name": "delete",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/view"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      }
+    },
+    "/views/{view}": {
+      "get": {
+        "tags": [
+          "show"
+        ],
+        "summary": "List one view",
+        "operationId": "list_one_view",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "view",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/view"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      }
+    },
+    "/views/{view}/dns": {
+      "get": {
+        "tags": [
+          "list"
+        ],
+        "summary": "Show all dns records of view",
+        "operationId": "show_view_dns_records",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "view",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/dns_records"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      },
+      "put": {
+        "consumes": [
+          "application/json; charset=utf-8"
+        ],
+        "produces": [
+          "application/json; charset=utf-8"
+        ],
+        "tags": [
+          "update"
+        ],
+        "summary": "Update dns entry of view",
+        "operationId": "update_view_dns_entry",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "view",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "update",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/dns_entry"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/dns_entry"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      },
+      "post": {
+        "consumes": [
+          "application/json; charset=utf-8"
+        ],
+        "produces": [
+          "application/json; charset=utf-8"
+        ],
+        "tags": [
+          "add"
+        ],
+        "summary": "Add dns entry to view",
+        "operationId": "add_view_dns_entry",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "view",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "add",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/dns_entry"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/dns_entry"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "delete"
+        ],
+        "summary": "Delete dns entry of view",
+        "operationId": "delete_view_dns_entry",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "view",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "delete",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/dns_entry"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      }
+    },
+    "/views/{view}/dns/{domain}": {
+      "get": {
+        "tags": [
+          "show"
+        ],
+        "summary": "List one dns entry of view",
+        "operationId": "list_one_view_dns_entry",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "view",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "domain",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/dns_entry"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/answer"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "acl": {
+      "type": "object",
+      "properties": {
+        "elements": {
+          "description": "networks, addresses, key:\u003ctsig key\u003e, names of other lists, ! negates",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "acls": {
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/acl"
+      }
+    },
+    "answer": {
+      "type": "object",
+      "properties": {
+        "Code": {
+          "type": "integer",
+          "format": "uint32"
+        },
+        "Message": {
+          "type": "string"
+        }
+      }
+    },
+    "balance": {
+      "description": "selection of answered addresses among healthy ones",
+      "type": "object",
+      "properties": {
+        "count": {
+          "description": "number of addresses of weighted policy, 1 by default",
+          "type": "integer"
+        },
+        "policy": {
+          "description": "all in stored order, round_robin rotates, weighted picks count addresses at random by weight, first_healthy answers the first one",
+          "type": "string",
+          "enum": [
+            "all",
+            "round_robin",
+            "weighted",
+            "first_healthy"
+          ]
+        },
+        "weights": {
+          "description": "weights of addresses for weighted policy, 1 by default, 0 drains address",
+          "type": "object",
+          "additionalProperties": {
+            "type": "integer"
+          }
+        }
+      }
+    },
+    "bimi_policy": {
+      "description": "BIMI record of zone published at default._bimi, receivers show the logo only with DMARC policy quarantine or reject",
+      "type": "object",
+      "properties": {
+        "authority": {
+          "description": "https URL of verified mark certificate in PEM format",
+          "type": "string"
+        },
+        "location": {
+          "description": "https URL of logo in SVG Tiny PS format",
+          "type": "string"
+        }
+      }
+    },
+    "blocklist": {
+      "type": "object",
+      "properties": {
+        "allow": {
+          "description": "names of the list are never blocked",
+          "type": "boolean"
+        },
+        "entries": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "format": {
+          "type": "string",
+          "enum": [
+            "hosts",
+            "domains",
+            "adblock"
+          ]
+        },
+        "groups": {
+          "description": "access lists of clients the list applies to, empty for all clients",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "hits": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "name": {
+          "type": "string"
+        },
+        "order": {
+          "description": "lists are checked in ascending order, hits count for the first matching block list",
+          "type": "integer"
+        },
+        "source": {
+          "description": "path to local file or http(s) url",
+          "type": "string"
+        },
+        "updated": {
+          "type": "string",
+          "readOnly": true
+        }
+      }
+    },
+    "blocklists": {
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/blocklist"
+      }
+    },
+    "delegation": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "description": "child zone, absolute or relative to zone like sub",
+          "type": "string"
+        },
+        "name_servers": {
+          "description": "NS records of child zone, name servers below child zone need addresses",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/name_server"
+          }
+        }
+      }
+    },
+    "dkim_key": {
+      "type": "object",
+      "properties": {
+        "activated": {
+          "description": "time of start of signing in RFC 3339 format",
+          "type": "string"
+        },
+        "algorithm": {
+          "description": "rsa-2048 by default",
+          "type": "string",
+          "enum": [
+            "rsa-2048",
+            "rsa-4096",
+            "ed25519"
+          ]
+        },
+        "created": {
+          "description": "time of publishing in RFC 3339 format",
+          "type": "string"
+        },
+        "private_key": {
+          "description": "base64 private key, PKCS1 for rsa, PKCS8 for ed25519",
+          "type": "string"
+        },
+        "public_key": {
+          "description": "base64 public key of DKIM record, SubjectPublicKeyInfo for rsa, raw key for ed25519",
+          "type": "string"
+        },
+        "retired": {
+          "description": "time of end of signing in RFC 3339 format",
+          "type": "string"
+        },
+        "revoked": {
+          "description": "time of revocation in RFC 3339 format",
+          "type": "string"
+        },
+        "selector": {
+          "description": "published at \u003cselector\u003e._domainkey of zone",
+          "type": "string"
+        },
+        "state": {
+          "description": "published waits for activation, active signs, retired and revoked stay published until retire period ends",
+          "type": "string",
+          "enum": [
+            "published",
+            "active",
+            "retired",
+            "revoked"
+          ]
+        }
+      }
+    },
+    "dkim_keys": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/dkim_key"
+      }
+    },
+    "dkim_pem": {
+      "type": "object",
+      "properties": {
+        "algorithm": {
+          "type": "string"
+        },
+        "pem": {
+          "description": "private key in PEM format for MTAs",
+          "type": "string"
+        },
+        "selector": {
+          "type": "string"
+        }
+      }
+    },
+    "dmarc_policy": {
+      "description": "DMARC record of zone (RFC 7489)",
+      "type": "object",
+      "properties": {
+        "adkim": {
+          "description": "DKIM alignment, relaxed by default",
+          "type": "string",
+          "enum": [
+            "relaxed",
+            "strict"
+          ]
+        },
+        "aspf": {
+          "description": "SPF alignment, relaxed by default",
+          "type": "string",
+          "enum": [
+            "relaxed",
+            "strict"
+          ]
+        },
+        "pct": {
+          "description": "percentage of messages the policy applies to, 100 by default",
+          "type": "integer",
+          "maximum": 100,
+          "minimum": 0,
+          "x-nullable": true
+        },
+        "policy": {
+          "description": "policy of zone, none by default",
+          "type": "string",
+          "enum": [
+            "none",
+            "quarantine",
+            "reject"
+          ]
+        },
+        "rua": {
+          "description": "addresses of aggregate reports, mailto URIs or plain addresses",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "ruf": {
+          "description": "addresses of failure reports, mailto URIs or plain addresses",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "subdomain_policy": {
+          "description": "policy of subdomains, policy of zone by default",
+          "type": "string",
+          "enum": [
+            "none",
+            "quarantine",
+            "reject"
+          ]
+        }
+      }
+    },
+    "dns_entry": {
+      "type": "object",
+      "properties": {
+        "acme": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "auto_ptr": {
+          "description": "reverse zone answers PTR queries with names of entries which have the queried address",
+          "type": "boolean"
+        },
+        "balance": {
+          "$ref": "#/definitions/balance"
+        },
+        "delegations": {
+          "description": "child zones on other name servers, queries at or below them are answered with referrals",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/delegation"
+          }
+        },
+        "dkim_keys": {
+          "description": "DKIM keys of zone, a key of selector mail is generated with zone",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/dkim_key"
+          }
+        },
+        "dkim_private_key": {
+          "description": "private key of active DKIM key, see dkim_keys",
+          "type": "string"
+        },
+        "dkim_public_key": {
+          "description": "public key of active DKIM key, see dkim_keys",
+          "type": "string"
+        },
+        "domain": {
+          "type": "string"
+        },
+        "geo": {
+          "description": "addresses for clients by location of GeoIP database, asn before country before continent, ipv4s and ipv6s are the default",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/geo_records"
+          }
+        },
+        "health_check": {
+          "$ref": "#/definitions/health_check"
+        },
+        "ipv4s": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "ipv6_synthesis": {
+          "description": "AAAA records for ipv4s without ipv6s, none by default, mapped as ::ffff:a.b.c.d, nat64 under nat64_prefix",
+          "type": "string",
+          "enum": [
+            "none",
+            "mapped",
+            "nat64"
+          ]
+        },
+        "ipv6s": {
+          "description": "ipv6 addresses, AAAA records are synthesized from ipv4s only without them and with ipv6_synthesis",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "mail": {
+          "$ref": "#/definitions/mail_policy"
+        },
+        "name_servers": {
+          "description": "NS records of zone with addresses of name servers, ns1 and ns2 of zone by default",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/name_server"
+          }
+        },
+        "nat64_prefix": {
+          "description": "prefix of nat64 synthesis of length 32, 40, 48, 56, 64 or 96 (RFC 6052), 64:ff9b::/96 by default",
+          "type": "string"
+        },
+        "ptrs": {
+          "description": "records of reverse zone, CNAMEs of parent zone and PTRs of child zone for classless delegation (RFC 2317)",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ptr_record"
+          }
+        },
+        "srvs": {
+          "description": "SRV records of services in zone, addresses of targets in zone are sent in additional section",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/srv_record"
+          }
+        },
+        "subnets": {
+          "description": "addresses for clients in networks, longest prefix wins, client subnet of EDNS is used when present",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/subnet_records"
+          }
+        },
+        "txts": {
+          "description": "TXT records matched by exact owner name, an SPF or DMARC record replaces the generated one",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/txt_record"
+          }
+        }
+      }
+    },
+    "dns_records": {
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/dns_entry"
+      }
+    },
+    "geo_records": {
+      "type": "object",
+      "properties": {
+        "asns": {
+          "description": "autonomous system numbers",
+          "type": "array",
+          "items": {
+            "type": "integer"
+          }
+        },
+        "continents": {
+          "description": "continent codes like EU",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "countries": {
+          "description": "ISO 3166 country codes like DE",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "ipv4s": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "ipv6s": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "health_check": {
+      "description": "check of every address of dns entry, only healthy addresses are answered",
+      "type": "object",
+      "properties": {
+        "all_down": {
+          "description": "answer with all addresses or with none when every address is down",
+          "type": "string",
+          "enum": [
+            "all",
+            "none"
+          ]
+        },
+        "expect_status": {
+          "description": "status of http response, 200 by default",
+          "type": "integer"
+        },
+        "fall": {
+          "description": "failed checks until address is down, 3 by default",
+          "type": "integer"
+        },
+        "host": {
+          "description": "host header and server name of http request, domain by default",
+          "type": "string"
+        },
+        "interval": {
+          "description": "seconds between checks, 10 by default",
+          "type": "integer"
+        },
+        "path": {
+          "description": "path of http request",
+          "type": "string"
+        },
+        "port": {
+          "description": "port of check, 80 for http, 443 for https, 53 for dns by default",
+          "type": "integer"
+        },
+        "query_name": {
+          "description": "name of dns query, domain by default",
+          "type": "string"
+        },
+        "query_type": {
+          "description": "type of dns query, A by default",
+          "type": "string"
+        },
+        "rise": {
+          "description": "successful checks until address is healthy again, 2 by default",
+          "type": "integer"
+        },
+        "timeout": {
+          "description": "seconds until check fails, 2 by default",
+          "type": "integer"
+        },
+        "type": {
+          "description": "tcp connects, ping accepts refused connections too, http and https expect status, dns expects NOERROR",
+          "type": "string",
+          "enum": [
+            "tcp",
+            "ping",
+            "http",
+            "https",
+            "dns"
+          ]
+        }
+      }
+    },
+    "health_status": {
+      "type": "object",
+      "properties": {
+        "address": {
+          "type": "string"
+        },
+        "checked": {
+          "description": "time of last check",
+          "type": "string",
+          "format": "date-time"
+        },
+        "error": {
+          "description": "error of last failed check",
+          "type": "string"
+        },
+        "failures": {
+          "description": "failed checks in a row",
+          "type": "integer"
+        },
+        "healthy": {
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "successes": {
+          "description": "successful checks in a row",
+          "type": "integer"
+        }
+      }
+    },
+    "health_status_list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/health_status"
+      }
+    },
+    "health_statuses": {
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/health_status_list"
+      }
+    },
+    "mail_policy": {
+      "description": "mail policy of zone, kept by updates of dns entry without it",
+      "type": "object",
+      "properties": {
+        "bimi": {
+          "$ref": "#/definitions/bimi_policy"
+        },
+        "dmarc": {
+          "$ref": "#/definitions/dmarc_policy"
+        },
+        "mta_sts": {
+          "$ref": "#/definitions/mta_sts_policy"
+        },
+        "spf": {
+          "$ref": "#/definitions/spf_policy"
+        },
+        "tls_rpt": {
+          "$ref": "#/definitions/tls_rpt_policy"
+        }
+      }
+    },
+    "mta_sts_policy": {
+      "description": "MTA-STS policy of zone (RFC 8461), published at _mta-sts and served as mta-sts.txt over https by mdns",
+      "type": "object",
+      "properties": {
+        "max_age": {
+          "description": "seconds senders cache the policy, one week by default",
+          "type": "integer",
+          "maximum": 31557600,
+          "minimum": 0
+        },
+        "mode": {
+          "description": "mode of policy, testing by default",
+          "type": "string",
+          "enum": [
+            "enforce",
+            "testing",
+            "none"
+          ]
+        },
+        "mx": {
+          "description": "names of mail servers, a leading *. matches one label, MX of zone by default",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "name_server": {
+      "type": "object",
+      "properties": {
+        "ipv4s": {
+          "description": "addresses of name server below zone, sent as glue",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "ipv6s": {
+          "description": "addresses of name server below zone, sent as glue",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "description": "host name of name server, absolute or relative to zone like ns1",
+          "type": "string"
+        }
+      }
+    },
+    "override": {
+      "type": "object",
+      "properties": {
+        "ipv4s": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "ipv6s": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "overrides": {
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/override"
+      }
+    },
+    "preview": {
+      "type": "object",
+      "properties": {
+        "asn": {
+          "type": "integer"
+        },
+        "continent": {
+          "type": "string"
+        },
+        "country": {
+          "type": "string"
+        },
+        "domain": {
+          "type": "string"
+        },
+        "ip": {
+          "type": "string"
+        },
+        "ipv4s": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "ipv6s": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "ptr_record": {
+      "type": "object",
+      "properties": {
+        "cname": {
+          "description": "name in zone of classless delegation instead of ptr",
+          "type": "string"
+        },
+        "name": {
+          "description": "owner name, absolute or relative to reverse zone like 1 or 1.0-25",
+          "type": "string"
+        },
+        "ptr": {
+          "description": "host name of address",
+          "type": "string"
+        }
+      }
+    },
+    "rrl": {
+      "type": "object",
+      "properties": {
+        "dropped": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "errors_per_second": {
+          "description": "error answers per second for one client prefix, 0 takes responses_per_second",
+          "type": "integer",
+          "format": "int64"
+        },
+        "exempt": {
+          "description": "access lists of clients which are never limited",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "exempted": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "ipv4_prefix_length": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "ipv6_prefix_length": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "nxdomains_per_second": {
+          "description": "nxdomain answers per second for one client prefix and zone, 0 takes responses_per_second",
+          "type": "integer",
+          "format": "int64"
+        },
+        "responses": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "responses_per_second": {
+          "description": "answers and empty answers per second for one client prefix and name, 0 disables limiting",
+          "type": "integer",
+          "format": "int64"
+        },
+        "slip": {
+          "description": "every slip limited answer is sent truncated instead of dropped, 0 drops all",
+          "type": "integer",
+          "format": "int64"
+        },
+        "slipped": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "window": {
+          "description": "seconds of over limit answers which are remembered",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "rrl_update": {
+      "description": "settings of response rate limiting, omitted settings are kept",
+      "type": "object",
+      "properties": {
+        "errors_per_second": {
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "exempt": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-nullable": true
+        },
+        "ipv4_prefix_length": {
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "ipv6_prefix_length": {
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "nxdomains_per_second": {
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "responses_per_second": {
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "slip": {
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "window": {
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        }
+      }
+    },
+    "spf_policy": {
+      "description": "SPF record of zone (RFC 7208), without mechanisms and includes the addresses of zone, a and mx are allowed",
+      "type": "object",
+      "properties": {
+        "includes": {
+          "description": "domains of include mechanisms like _spf.google.com",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "mechanisms": {
+          "description": "mechanisms and modifiers before all like a, mx, ip4:192.0.2.0/24, ip6:2001:db8::/32, exists:%{i}.example.com",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "qualifier": {
+          "description": "qualifier of all mechanism, softfail by default",
+          "type": "string",
+          "enum": [
+            "fail",
+            "softfail",
+            "neutral"
+          ]
+        }
+      }
+    },
+    "srv_record": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "description": "owner name of service, absolute or relative to zone like _sip._tcp",
+          "type": "string"
+        },
+        "port": {
+          "type": "integer"
+        },
+        "priority": {
+          "type": "integer",
+          "x-omitempty": false
+        },
+        "target": {
+          "description": "host name of service, absolute or relative to zone, . when service is not available",
+          "type": "string"
+        },
+        "weight": {
+          "type": "integer",
+          "x-omitempty": false
+        }
+      }
+    },
+    "subnet_records": {
+      "type": "object",
+      "properties": {
+        "ipv4s": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "ipv6s": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "subnet": {
+          "description": "network in CIDR notation",
+          "type": "string"
+        }
+      }
+    },
+    "tls_rpt_policy": {
+      "description": "SMTP TLS reporting of zone (RFC 8460), published at _smtp._tls",
+      "type": "object",
+      "properties": {
+        "rua": {
+          "description": "destinations of reports, mailto or https URIs or plain addresses",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "txt_record": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "description": "owner name, absolute or relative to zone like _github-challenge, @ for zone",
+          "type": "string"
+        },
+        "values": {
+          "description": "strings of one TXT record, strings over 255 bytes are split",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "view": {
+      "type": "object",
+      "properties": {
+        "clients": {
+          "description": "networks, addresses, key:\u003ctsig key\u003e, names of access lists, ! negates, any client when empty",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "listeners": {
+          "description": "local addresses \"ip\" or \"ip:port\" of listeners, which servers are bound to by LISTEN, any listener when empty",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "order": {
+          "description": "views are matched in ascending order, the first matching view answers with its zones",
+          "type": "integer"
+        }
+      }
+    },
+    "views": {
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/view"
       }
     }
   }
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package add
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// AddACLHandlerFunc turns a function with the right signature into a add acl handler
+type AddACLHandlerFunc func(AddACLParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn AddACLHandlerFunc) Handle(params AddACLParams) middleware.Responder {
+	return fn(params)
+}
+
+// AddACLHandler interface for that can handle valid add acl params
+type AddACLHandler interface {
+	Handle(AddACLParams) middleware.Responder
+}
+
+// NewAddACL creates a new http.Handler for the add acl operation
+func NewAddACL(ctx *middleware.Context, handler AddACLHandler) *AddACL {
+	return &AddACL{Context: ctx, Handler: handler}
+}
+
+/*
+	AddACL swagger:route POST /acl add addAcl
+
+Add or replace access control list
+*/
+type AddACL struct {
+	Context *middleware.Context
+	Handler AddACLHandler
+}
+
+func (o *AddACL) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewAddACLParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package add
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+// AddACLOKCode is the HTTP code returned for type AddACLOK
+const AddACLOKCode int = 200
+
+/*
+AddACLOK OK
+
+swagger:response addAclOK
+*/
+type AddACLOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ACL `json:"body,omitempty"`
+}
+
+// NewAddACLOK creates AddACLOK with default headers values
+func NewAddACLOK() *AddACLOK {
+
+	return &AddACLOK{}
+}
+
+// WithPayload adds the payload to the add Acl o k response
+func (o *AddACLOK) WithPayload(payload *models.ACL) *AddACLOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the add Acl o k response
+func (o *AddACLOK) SetPayload(payload *models.ACL) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AddACLOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// AddACLBadRequestCode is the HTTP code returned for type AddACLBadRequest
+const AddACLBadRequestCode int = 400
+
+/*
+AddACLBadRequest Bad request
+
+swagger:response addAclBadRequest
+*/
+type AddACLBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewAddACLBadRequest creates AddACLBadRequest with default headers values
+func NewAddACLBadRequest() *AddACLBadRequest {
+
+	return &AddACLBadRequest{}
+}
+
+// WithPayload adds the payload to the add Acl bad request response
+func (o *AddACLBadRequest) WithPayload(payload *models.Answer) *AddACLBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the add Acl bad request response
+func (o *AddACLBadRequest) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AddACLBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
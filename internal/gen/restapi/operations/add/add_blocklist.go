@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package add
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// AddBlocklistHandlerFunc turns a function with the right signature into a add blocklist handler
+type AddBlocklistHandlerFunc func(AddBlocklistParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn AddBlocklistHandlerFunc) Handle(params AddBlocklistParams) middleware.Responder {
+	return fn(params)
+}
+
+// AddBlocklistHandler interface for that can handle valid add blocklist params
+type AddBlocklistHandler interface {
+	Handle(AddBlocklistParams) middleware.Responder
+}
+
+// NewAddBlocklist creates a new http.Handler for the add blocklist operation
+func NewAddBlocklist(ctx *middleware.Context, handler AddBlocklistHandler) *AddBlocklist {
+	return &AddBlocklist{Context: ctx, Handler: handler}
+}
+
+/*
+	AddBlocklist swagger:route POST /blocklist add addBlocklist
+
+Add or replace block list, the list is loaded immediately
+*/
+type AddBlocklist struct {
+	Context *middleware.Context
+	Handler AddBlocklistHandler
+}
+
+func (o *AddBlocklist) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewAddBlocklistParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package add
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+// AddBlocklistOKCode is the HTTP code returned for type AddBlocklistOK
+const AddBlocklistOKCode int = 200
+
+/*
+AddBlocklistOK OK
+
+swagger:response addBlocklistOK
+*/
+type AddBlocklistOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Blocklist `json:"body,omitempty"`
+}
+
+// NewAddBlocklistOK creates AddBlocklistOK with default headers values
+func NewAddBlocklistOK() *AddBlocklistOK {
+
+	return &AddBlocklistOK{}
+}
+
+// WithPayload adds the payload to the add blocklist o k response
+func (o *AddBlocklistOK) WithPayload(payload *models.Blocklist) *AddBlocklistOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the add blocklist o k response
+func (o *AddBlocklistOK) SetPayload(payload *models.Blocklist) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AddBlocklistOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// AddBlocklistBadRequestCode is the HTTP code returned for type AddBlocklistBadRequest
+const AddBlocklistBadRequestCode int = 400
+
+/*
+AddBlocklistBadRequest Bad request
+
+swagger:response addBlocklistBadRequest
+*/
+type AddBlocklistBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewAddBlocklistBadRequest creates AddBlocklistBadRequest with default headers values
+func NewAddBlocklistBadRequest() *AddBlocklistBadRequest {
+
+	return &AddBlocklistBadRequest{}
+}
+
+// WithPayload adds the payload to the add blocklist bad request response
+func (o *AddBlocklistBadRequest) WithPayload(payload *models.Answer) *AddBlocklistBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the add blocklist bad request response
+func (o *AddBlocklistBadRequest) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AddBlocklistBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
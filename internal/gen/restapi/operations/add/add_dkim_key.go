@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package add
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// AddDkimKeyHandlerFunc turns a function with the right signature into a add dkim key handler
+type AddDkimKeyHandlerFunc func(AddDkimKeyParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn AddDkimKeyHandlerFunc) Handle(params AddDkimKeyParams) middleware.Responder {
+	return fn(params)
+}
+
+// AddDkimKeyHandler interface for that can handle valid add dkim key params
+type AddDkimKeyHandler interface {
+	Handle(AddDkimKeyParams) middleware.Responder
+}
+
+// NewAddDkimKey creates a new http.Handler for the add dkim key operation
+func NewAddDkimKey(ctx *middleware.Context, handler AddDkimKeyHandler) *AddDkimKey {
+	return &AddDkimKey{Context: ctx, Handler: handler}
+}
+
+/*
+	AddDkimKey swagger:route POST /dns/{domain}/dkim add addDkimKey
+
+Add DKIM key with selector, it signs at once without active key, otherwise after publish delay
+*/
+type AddDkimKey struct {
+	Context *middleware.Context
+	Handler AddDkimKeyHandler
+}
+
+func (o *AddDkimKey) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewAddDkimKeyParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package add
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+// AddDkimKeyOKCode is the HTTP code returned for type AddDkimKeyOK
+const AddDkimKeyOKCode int = 200
+
+/*
+AddDkimKeyOK OK
+
+swagger:response addDkimKeyOK
+*/
+type AddDkimKeyOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.DkimKey `json:"body,omitempty"`
+}
+
+// NewAddDkimKeyOK creates AddDkimKeyOK with default headers values
+func NewAddDkimKeyOK() *AddDkimKeyOK {
+
+	return &AddDkimKeyOK{}
+}
+
+// WithPayload adds the payload to the add dkim key o k response
+func (o *AddDkimKeyOK) WithPayload(payload *models.DkimKey) *AddDkimKeyOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the add dkim key o k response
+func (o *AddDkimKeyOK) SetPayload(payload *models.DkimKey) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AddDkimKeyOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// AddDkimKeyBadRequestCode is the HTTP code returned for type AddDkimKeyBadRequest
+const AddDkimKeyBadRequestCode int = 400
+
+/*
+AddDkimKeyBadRequest Bad request
+
+swagger:response addDkimKeyBadRequest
+*/
+type AddDkimKeyBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewAddDkimKeyBadRequest creates AddDkimKeyBadRequest with default headers values
+func NewAddDkimKeyBadRequest() *AddDkimKeyBadRequest {
+
+	return &AddDkimKeyBadRequest{}
+}
+
+// WithPayload adds the payload to the add dkim key bad request response
+func (o *AddDkimKeyBadRequest) WithPayload(payload *models.Answer) *AddDkimKeyBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the add dkim key bad request response
+func (o *AddDkimKeyBadRequest) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AddDkimKeyBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
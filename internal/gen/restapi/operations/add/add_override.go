@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package add
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// AddOverrideHandlerFunc turns a function with the right signature into a add override handler
+type AddOverrideHandlerFunc func(AddOverrideParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn AddOverrideHandlerFunc) Handle(params AddOverrideParams) middleware.Responder {
+	return fn(params)
+}
+
+// AddOverrideHandler interface for that can handle valid add override params
+type AddOverrideHandler interface {
+	Handle(AddOverrideParams) middleware.Responder
+}
+
+// NewAddOverride creates a new http.Handler for the add override operation
+func NewAddOverride(ctx *middleware.Context, handler AddOverrideHandler) *AddOverride {
+	return &AddOverride{Context: ctx, Handler: handler}
+}
+
+/*
+	AddOverride swagger:route POST /override add addOverride
+
+Add or replace static override of exact name
+*/
+type AddOverride struct {
+	Context *middleware.Context
+	Handler AddOverrideHandler
+}
+
+func (o *AddOverride) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewAddOverrideParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package add
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+// AddOverrideOKCode is the HTTP code returned for type AddOverrideOK
+const AddOverrideOKCode int = 200
+
+/*
+AddOverrideOK OK
+
+swagger:response addOverrideOK
+*/
+type AddOverrideOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Override `json:"body,omitempty"`
+}
+
+// NewAddOverrideOK creates AddOverrideOK with default headers values
+func NewAddOverrideOK() *AddOverrideOK {
+
+	return &AddOverrideOK{}
+}
+
+// WithPayload adds the payload to the add override o k response
+func (o *AddOverrideOK) WithPayload(payload *models.Override) *AddOverrideOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the add override o k response
+func (o *AddOverrideOK) SetPayload(payload *models.Override) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AddOverrideOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// AddOverrideBadRequestCode is the HTTP code returned for type AddOverrideBadRequest
+const AddOverrideBadRequestCode int = 400
+
+/*
+AddOverrideBadRequest Bad request
+
+swagger:response addOverrideBadRequest
+*/
+type AddOverrideBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewAddOverrideBadRequest creates AddOverrideBadRequest with default headers values
+func NewAddOverrideBadRequest() *AddOverrideBadRequest {
+
+	return &AddOverrideBadRequest{}
+}
+
+// WithPayload adds the payload to the add override bad request response
+func (o *AddOverrideBadRequest) WithPayload(payload *models.Answer) *AddOverrideBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the add override bad request response
+func (o *AddOverrideBadRequest) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AddOverrideBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
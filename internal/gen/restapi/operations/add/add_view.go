@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package add
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// AddViewHandlerFunc turns a function with the right signature into a add view handler
+type AddViewHandlerFunc func(AddViewParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn AddViewHandlerFunc) Handle(params AddViewParams) middleware.Responder {
+	return fn(params)
+}
+
+// AddViewHandler interface for that can handle valid add view params
+type AddViewHandler interface {
+	Handle(AddViewParams) middleware.Responder
+}
+
+// NewAddView creates a new http.Handler for the add view operation
+func NewAddView(ctx *middleware.Context, handler AddViewHandler) *AddView {
+	return &AddView{Context: ctx, Handler: handler}
+}
+
+/*
+	AddView swagger:route POST /views add addView
+
+Add or replace view, zones of replaced view are kept
+*/
+type AddView struct {
+	Context *middleware.Context
+	Handler AddViewHandler
+}
+
+func (o *AddView) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewAddViewParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package add
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// AddViewDNSEntryHandlerFunc turns a function with the right signature into a add view dns entry handler
+type AddViewDNSEntryHandlerFunc func(AddViewDNSEntryParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn AddViewDNSEntryHandlerFunc) Handle(params AddViewDNSEntryParams) middleware.Responder {
+	return fn(params)
+}
+
+// AddViewDNSEntryHandler interface for that can handle valid add view dns entry params
+type AddViewDNSEntryHandler interface {
+	Handle(AddViewDNSEntryParams) middleware.Responder
+}
+
+// NewAddViewDNSEntry creates a new http.Handler for the add view dns entry operation
+func NewAddViewDNSEntry(ctx *middleware.Context, handler AddViewDNSEntryHandler) *AddViewDNSEntry {
+	return &AddViewDNSEntry{Context: ctx, Handler: handler}
+}
+
+/*
+	AddViewDNSEntry swagger:route POST /views/{view}/dns add addViewDnsEntry
+
+Add dns entry to view
+*/
+type AddViewDNSEntry struct {
+	Context *middleware.Context
+	Handler AddViewDNSEntryHandler
+}
+
+func (o *AddViewDNSEntry) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewAddViewDNSEntryParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
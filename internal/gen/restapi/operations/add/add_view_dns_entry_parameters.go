@@ -0,0 +1,110 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package add
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+// NewAddViewDNSEntryParams creates a new AddViewDNSEntryParams object
+//
+// There are no default values defined in the spec.
+func NewAddViewDNSEntryParams() AddViewDNSEntryParams {
+
+	return AddViewDNSEntryParams{}
+}
+
+// AddViewDNSEntryParams contains all the bound params for the add view dns entry operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters add_view_dns_entry
+type AddViewDNSEntryParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  Required: true
+	  In: body
+	*/
+	Add *models.DNSEntry
+	/*
+	  Required: true
+	  In: path
+	*/
+	View string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewAddViewDNSEntryParams() beforehand.
+func (o *AddViewDNSEntryParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body models.DNSEntry
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			if err == io.EOF {
+				res = append(res, errors.Required("add", "body", ""))
+			} else {
+				res = append(res, errors.NewParseError("add", "body", "", err))
+			}
+		} else {
+			// validate body object
+			if err := body.Validate(route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			ctx := validate.WithOperationRequest(context.Background())
+			if err := body.ContextValidate(ctx, route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			if len(res) == 0 {
+				o.Add = &body
+			}
+		}
+	} else {
+		res = append(res, errors.Required("add", "body", ""))
+	}
+
+	rView, rhkView, _ := route.Params.GetOK("view")
+	if err := o.bindView(rView, rhkView, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindView binds and validates parameter View from path.
+func (o *AddViewDNSEntryParams) bindView(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.View = raw
+
+	return nil
+}
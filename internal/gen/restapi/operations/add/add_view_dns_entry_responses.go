@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package add
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+// AddViewDNSEntryOKCode is the HTTP code returned for type AddViewDNSEntryOK
+const AddViewDNSEntryOKCode int = 200
+
+/*
+AddViewDNSEntryOK OK
+
+swagger:response addViewDnsEntryOK
+*/
+type AddViewDNSEntryOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.DNSEntry `json:"body,omitempty"`
+}
+
+// NewAddViewDNSEntryOK creates AddViewDNSEntryOK with default headers values
+func NewAddViewDNSEntryOK() *AddViewDNSEntryOK {
+
+	return &AddViewDNSEntryOK{}
+}
+
+// WithPayload adds the payload to the add view Dns entry o k response
+func (o *AddViewDNSEntryOK) WithPayload(payload *models.DNSEntry) *AddViewDNSEntryOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the add view Dns entry o k response
+func (o *AddViewDNSEntryOK) SetPayload(payload *models.DNSEntry) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AddViewDNSEntryOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// AddViewDNSEntryBadRequestCode is the HTTP code returned for type AddViewDNSEntryBadRequest
+const AddViewDNSEntryBadRequestCode int = 400
+
+/*
+AddViewDNSEntryBadRequest Bad request
+
+swagger:response addViewDnsEntryBadRequest
+*/
+type AddViewDNSEntryBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewAddViewDNSEntryBadRequest creates AddViewDNSEntryBadRequest with default headers values
+func NewAddViewDNSEntryBadRequest() *AddViewDNSEntryBadRequest {
+
+	return &AddViewDNSEntryBadRequest{}
+}
+
+// WithPayload adds the payload to the add view Dns entry bad request response
+func (o *AddViewDNSEntryBadRequest) WithPayload(payload *models.Answer) *AddViewDNSEntryBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the add view Dns entry bad request response
+func (o *AddViewDNSEntryBadRequest) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AddViewDNSEntryBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package add
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+// AddViewOKCode is the HTTP code returned for type AddViewOK
+const AddViewOKCode int = 200
+
+/*
+AddViewOK OK
+
+swagger:response addViewOK
+*/
+type AddViewOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.View `json:"body,omitempty"`
+}
+
+// NewAddViewOK creates AddViewOK with default headers values
+func NewAddViewOK() *AddViewOK {
+
+	return &AddViewOK{}
+}
+
+// WithPayload adds the payload to the add view o k response
+func (o *AddViewOK) WithPayload(payload *models.View) *AddViewOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the add view o k response
+func (o *AddViewOK) SetPayload(payload *models.View) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AddViewOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// AddViewBadRequestCode is the HTTP code returned for type AddViewBadRequest
+const AddViewBadRequestCode int = 400
+
+/*
+AddViewBadRequest Bad request
+
+swagger:response addViewBadRequest
+*/
+type AddViewBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewAddViewBadRequest creates AddViewBadRequest with default headers values
+func NewAddViewBadRequest() *AddViewBadRequest {
+
+	return &AddViewBadRequest{}
+}
+
+// WithPayload adds the payload to the add view bad request response
+func (o *AddViewBadRequest) WithPayload(payload *models.Answer) *AddViewBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the add view bad request response
+func (o *AddViewBadRequest) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AddViewBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package delete
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// DeleteACLHandlerFunc turns a function with the right signature into a delete acl handler
+type DeleteACLHandlerFunc func(DeleteACLParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn DeleteACLHandlerFunc) Handle(params DeleteACLParams) middleware.Responder {
+	return fn(params)
+}
+
+// DeleteACLHandler interface for that can handle valid delete acl params
+type DeleteACLHandler interface {
+	Handle(DeleteACLParams) middleware.Responder
+}
+
+// NewDeleteACL creates a new http.Handler for the delete acl operation
+func NewDeleteACL(ctx *middleware.Context, handler DeleteACLHandler) *DeleteACL {
+	return &DeleteACL{Context: ctx, Handler: handler}
+}
+
+/*
+	DeleteACL swagger:route DELETE /acl delete deleteAcl
+
+Delete access control list
+*/
+type DeleteACL struct {
+	Context *middleware.Context
+	Handler DeleteACLHandler
+}
+
+func (o *DeleteACL) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewDeleteACLParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
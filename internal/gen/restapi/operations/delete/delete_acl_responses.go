@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package delete
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+// DeleteACLOKCode is the HTTP code returned for type DeleteACLOK
+const DeleteACLOKCode int = 200
+
+/*
+DeleteACLOK OK
+
+swagger:response deleteAclOK
+*/
+type DeleteACLOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewDeleteACLOK creates DeleteACLOK with default headers values
+func NewDeleteACLOK() *DeleteACLOK {
+
+	return &DeleteACLOK{}
+}
+
+// WithPayload adds the payload to the delete Acl o k response
+func (o *DeleteACLOK) WithPayload(payload *models.Answer) *DeleteACLOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete Acl o k response
+func (o *DeleteACLOK) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteACLOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteACLBadRequestCode is the HTTP code returned for type DeleteACLBadRequest
+const DeleteACLBadRequestCode int = 400
+
+/*
+DeleteACLBadRequest Bad request
+
+swagger:response deleteAclBadRequest
+*/
+type DeleteACLBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewDeleteACLBadRequest creates DeleteACLBadRequest with default headers values
+func NewDeleteACLBadRequest() *DeleteACLBadRequest {
+
+	return &DeleteACLBadRequest{}
+}
+
+// WithPayload adds the payload to the delete Acl bad request response
+func (o *DeleteACLBadRequest) WithPayload(payload *models.Answer) *DeleteACLBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete Acl bad request response
+func (o *DeleteACLBadRequest) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteACLBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package delete
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// DeleteBlocklistHandlerFunc turns a function with the right signature into a delete blocklist handler
+type DeleteBlocklistHandlerFunc func(DeleteBlocklistParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn DeleteBlocklistHandlerFunc) Handle(params DeleteBlocklistParams) middleware.Responder {
+	return fn(params)
+}
+
+// DeleteBlocklistHandler interface for that can handle valid delete blocklist params
+type DeleteBlocklistHandler interface {
+	Handle(DeleteBlocklistParams) middleware.Responder
+}
+
+// NewDeleteBlocklist creates a new http.Handler for the delete blocklist operation
+func NewDeleteBlocklist(ctx *middleware.Context, handler DeleteBlocklistHandler) *DeleteBlocklist {
+	return &DeleteBlocklist{Context: ctx, Handler: handler}
+}
+
+/*
+	DeleteBlocklist swagger:route DELETE /blocklist delete deleteBlocklist
+
+Delete block list
+*/
+type DeleteBlocklist struct {
+	Context *middleware.Context
+	Handler DeleteBlocklistHandler
+}
+
+func (o *DeleteBlocklist) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewDeleteBlocklistParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package delete
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+// DeleteBlocklistOKCode is the HTTP code returned for type DeleteBlocklistOK
+const DeleteBlocklistOKCode int = 200
+
+/*
+DeleteBlocklistOK OK
+
+swagger:response deleteBlocklistOK
+*/
+type DeleteBlocklistOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewDeleteBlocklistOK creates DeleteBlocklistOK with default headers values
+func NewDeleteBlocklistOK() *DeleteBlocklistOK {
+
+	return &DeleteBlocklistOK{}
+}
+
+// WithPayload adds the payload to the delete blocklist o k response
+func (o *DeleteBlocklistOK) WithPayload(payload *models.Answer) *DeleteBlocklistOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete blocklist o k response
+func (o *DeleteBlocklistOK) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteBlocklistOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteBlocklistBadRequestCode is the HTTP code returned for type DeleteBlocklistBadRequest
+const DeleteBlocklistBadRequestCode int = 400
+
+/*
+DeleteBlocklistBadRequest Bad request
+
+swagger:response deleteBlocklistBadRequest
+*/
+type DeleteBlocklistBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewDeleteBlocklistBadRequest creates DeleteBlocklistBadRequest with default headers values
+func NewDeleteBlocklistBadRequest() *DeleteBlocklistBadRequest {
+
+	return &DeleteBlocklistBadRequest{}
+}
+
+// WithPayload adds the payload to the delete blocklist bad request response
+func (o *DeleteBlocklistBadRequest) WithPayload(payload *models.Answer) *DeleteBlocklistBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete blocklist bad request response
+func (o *DeleteBlocklistBadRequest) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteBlocklistBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package delete
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// DeleteOverrideHandlerFunc turns a function with the right signature into a delete override handler
+type DeleteOverrideHandlerFunc func(DeleteOverrideParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn DeleteOverrideHandlerFunc) Handle(params DeleteOverrideParams) middleware.Responder {
+	return fn(params)
+}
+
+// DeleteOverrideHandler interface for that can handle valid delete override params
+type DeleteOverrideHandler interface {
+	Handle(DeleteOverrideParams) middleware.Responder
+}
+
+// NewDeleteOverride creates a new http.Handler for the delete override operation
+func NewDeleteOverride(ctx *middleware.Context, handler DeleteOverrideHandler) *DeleteOverride {
+	return &DeleteOverride{Context: ctx, Handler: handler}
+}
+
+/*
+	DeleteOverride swagger:route DELETE /override delete deleteOverride
+
+Delete static override
+*/
+type DeleteOverride struct {
+	Context *middleware.Context
+	Handler DeleteOverrideHandler
+}
+
+func (o *DeleteOverride) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewDeleteOverrideParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package delete
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+// DeleteOverrideOKCode is the HTTP code returned for type DeleteOverrideOK
+const DeleteOverrideOKCode int = 200
+
+/*
+DeleteOverrideOK OK
+
+swagger:response deleteOverrideOK
+*/
+type DeleteOverrideOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewDeleteOverrideOK creates DeleteOverrideOK with default headers values
+func NewDeleteOverrideOK() *DeleteOverrideOK {
+
+	return &DeleteOverrideOK{}
+}
+
+// WithPayload adds the payload to the delete override o k response
+func (o *DeleteOverrideOK) WithPayload(payload *models.Answer) *DeleteOverrideOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete override o k response
+func (o *DeleteOverrideOK) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteOverrideOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteOverrideBadRequestCode is the HTTP code returned for type DeleteOverrideBadRequest
+const DeleteOverrideBadRequestCode int = 400
+
+/*
+DeleteOverrideBadRequest Bad request
+
+swagger:response deleteOverrideBadRequest
+*/
+type DeleteOverrideBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewDeleteOverrideBadRequest creates DeleteOverrideBadRequest with default headers values
+func NewDeleteOverrideBadRequest() *DeleteOverrideBadRequest {
+
+	return &DeleteOverrideBadRequest{}
+}
+
+// WithPayload adds the payload to the delete override bad request response
+func (o *DeleteOverrideBadRequest) WithPayload(payload *models.Answer) *DeleteOverrideBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete override bad request response
+func (o *DeleteOverrideBadRequest) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteOverrideBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package delete
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// DeleteViewHandlerFunc turns a function with the right signature into a delete view handler
+type DeleteViewHandlerFunc func(DeleteViewParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn DeleteViewHandlerFunc) Handle(params DeleteViewParams) middleware.Responder {
+	return fn(params)
+}
+
+// DeleteViewHandler interface for that can handle valid delete view params
+type DeleteViewHandler interface {
+	Handle(DeleteViewParams) middleware.Responder
+}
+
+// NewDeleteView creates a new http.Handler for the delete view operation
+func NewDeleteView(ctx *middleware.Context, handler DeleteViewHandler) *DeleteView {
+	return &DeleteView{Context: ctx, Handler: handler}
+}
+
+/*
+	DeleteView swagger:route DELETE /views delete deleteView
+
+Delete view with its zones
+*/
+type DeleteView struct {
+	Context *middleware.Context
+	Handler DeleteViewHandler
+}
+
+func (o *DeleteView) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewDeleteViewParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
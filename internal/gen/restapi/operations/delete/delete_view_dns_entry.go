@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package delete
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// DeleteViewDNSEntryHandlerFunc turns a function with the right signature into a delete view dns entry handler
+type DeleteViewDNSEntryHandlerFunc func(DeleteViewDNSEntryParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn DeleteViewDNSEntryHandlerFunc) Handle(params DeleteViewDNSEntryParams) middleware.Responder {
+	return fn(params)
+}
+
+// DeleteViewDNSEntryHandler interface for that can handle valid delete view dns entry params
+type DeleteViewDNSEntryHandler interface {
+	Handle(DeleteViewDNSEntryParams) middleware.Responder
+}
+
+// NewDeleteViewDNSEntry creates a new http.Handler for the delete view dns entry operation
+func NewDeleteViewDNSEntry(ctx *middleware.Context, handler DeleteViewDNSEntryHandler) *DeleteViewDNSEntry {
+	return &DeleteViewDNSEntry{Context: ctx, Handler: handler}
+}
+
+/*
+	DeleteViewDNSEntry swagger:route DELETE /views/{view}/dns delete deleteViewDnsEntry
+
+Delete dns entry of view
+*/
+type DeleteViewDNSEntry struct {
+	Context *middleware.Context
+	Handler DeleteViewDNSEntryHandler
+}
+
+func (o *DeleteViewDNSEntry) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewDeleteViewDNSEntryParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
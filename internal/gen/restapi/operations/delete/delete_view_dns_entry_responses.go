@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package delete
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+// DeleteViewDNSEntryOKCode is the HTTP code returned for type DeleteViewDNSEntryOK
+const DeleteViewDNSEntryOKCode int = 200
+
+/*
+DeleteViewDNSEntryOK OK
+
+swagger:response deleteViewDnsEntryOK
+*/
+type DeleteViewDNSEntryOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewDeleteViewDNSEntryOK creates DeleteViewDNSEntryOK with default headers values
+func NewDeleteViewDNSEntryOK() *DeleteViewDNSEntryOK {
+
+	return &DeleteViewDNSEntryOK{}
+}
+
+// WithPayload adds the payload to the delete view Dns entry o k response
+func (o *DeleteViewDNSEntryOK) WithPayload(payload *models.Answer) *DeleteViewDNSEntryOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete view Dns entry o k response
+func (o *DeleteViewDNSEntryOK) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteViewDNSEntryOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteViewDNSEntryBadRequestCode is the HTTP code returned for type DeleteViewDNSEntryBadRequest
+const DeleteViewDNSEntryBadRequestCode int = 400
+
+/*
+DeleteViewDNSEntryBadRequest Bad request
+
+swagger:response deleteViewDnsEntryBadRequest
+*/
+type DeleteViewDNSEntryBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewDeleteViewDNSEntryBadRequest creates DeleteViewDNSEntryBadRequest with default headers values
+func NewDeleteViewDNSEntryBadRequest() *DeleteViewDNSEntryBadRequest {
+
+	return &DeleteViewDNSEntryBadRequest{}
+}
+
+// WithPayload adds the payload to the delete view Dns entry bad request response
+func (o *DeleteViewDNSEntryBadRequest) WithPayload(payload *models.Answer) *DeleteViewDNSEntryBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete view Dns entry bad request response
+func (o *DeleteViewDNSEntryBadRequest) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteViewDNSEntryBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
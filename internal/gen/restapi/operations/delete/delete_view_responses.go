@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package delete
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+// DeleteViewOKCode is the HTTP code returned for type DeleteViewOK
+const DeleteViewOKCode int = 200
+
+/*
+DeleteViewOK OK
+
+swagger:response deleteViewOK
+*/
+type DeleteViewOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewDeleteViewOK creates DeleteViewOK with default headers values
+func NewDeleteViewOK() *DeleteViewOK {
+
+	return &DeleteViewOK{}
+}
+
+// WithPayload adds the payload to the delete view o k response
+func (o *DeleteViewOK) WithPayload(payload *models.Answer) *DeleteViewOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete view o k response
+func (o *DeleteViewOK) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteViewOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteViewBadRequestCode is the HTTP code returned for type DeleteViewBadRequest
+const DeleteViewBadRequestCode int = 400
+
+/*
+DeleteViewBadRequest Bad request
+
+swagger:response deleteViewBadRequest
+*/
+type DeleteViewBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewDeleteViewBadRequest creates DeleteViewBadRequest with default headers values
+func NewDeleteViewBadRequest() *DeleteViewBadRequest {
+
+	return &DeleteViewBadRequest{}
+}
+
+// WithPayload adds the payload to the delete view bad request response
+func (o *DeleteViewBadRequest) WithPayload(payload *models.Answer) *DeleteViewBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete view bad request response
+func (o *DeleteViewBadRequest) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteViewBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package delete
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// RevokeDkimKeyHandlerFunc turns a function with the right signature into a revoke dkim key handler
+type RevokeDkimKeyHandlerFunc func(RevokeDkimKeyParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn RevokeDkimKeyHandlerFunc) Handle(params RevokeDkimKeyParams) middleware.Responder {
+	return fn(params)
+}
+
+// RevokeDkimKeyHandler interface for that can handle valid revoke dkim key params
+type RevokeDkimKeyHandler interface {
+	Handle(RevokeDkimKeyParams) middleware.Responder
+}
+
+// NewRevokeDkimKey creates a new http.Handler for the revoke dkim key operation
+func NewRevokeDkimKey(ctx *middleware.Context, handler RevokeDkimKeyHandler) *RevokeDkimKey {
+	return &RevokeDkimKey{Context: ctx, Handler: handler}
+}
+
+/*
+	RevokeDkimKey swagger:route DELETE /dns/{domain}/dkim delete revokeDkimKey
+
+Revoke DKIM key, its selector is published with empty key until retire period ends
+*/
+type RevokeDkimKey struct {
+	Context *middleware.Context
+	Handler RevokeDkimKeyHandler
+}
+
+func (o *RevokeDkimKey) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewRevokeDkimKeyParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
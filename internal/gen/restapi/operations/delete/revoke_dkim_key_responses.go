@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package delete
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+// RevokeDkimKeyOKCode is the HTTP code returned for type RevokeDkimKeyOK
+const RevokeDkimKeyOKCode int = 200
+
+/*
+RevokeDkimKeyOK OK
+
+swagger:response revokeDkimKeyOK
+*/
+type RevokeDkimKeyOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewRevokeDkimKeyOK creates RevokeDkimKeyOK with default headers values
+func NewRevokeDkimKeyOK() *RevokeDkimKeyOK {
+
+	return &RevokeDkimKeyOK{}
+}
+
+// WithPayload adds the payload to the revoke dkim key o k response
+func (o *RevokeDkimKeyOK) WithPayload(payload *models.Answer) *RevokeDkimKeyOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the revoke dkim key o k response
+func (o *RevokeDkimKeyOK) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RevokeDkimKeyOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RevokeDkimKeyBadRequestCode is the HTTP code returned for type RevokeDkimKeyBadRequest
+const RevokeDkimKeyBadRequestCode int = 400
+
+/*
+RevokeDkimKeyBadRequest Bad request
+
+swagger:response revokeDkimKeyBadRequest
+*/
+type RevokeDkimKeyBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewRevokeDkimKeyBadRequest creates RevokeDkimKeyBadRequest with default headers values
+func NewRevokeDkimKeyBadRequest() *RevokeDkimKeyBadRequest {
+
+	return &RevokeDkimKeyBadRequest{}
+}
+
+// WithPayload adds the payload to the revoke dkim key bad request response
+func (o *RevokeDkimKeyBadRequest) WithPayload(payload *models.Answer) *RevokeDkimKeyBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the revoke dkim key bad request response
+func (o *RevokeDkimKeyBadRequest) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RevokeDkimKeyBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
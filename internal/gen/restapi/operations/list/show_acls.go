@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package list
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ShowAclsHandlerFunc turns a function with the right signature into a show acls handler
+type ShowAclsHandlerFunc func(ShowAclsParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ShowAclsHandlerFunc) Handle(params ShowAclsParams) middleware.Responder {
+	return fn(params)
+}
+
+// ShowAclsHandler interface for that can handle valid show acls params
+type ShowAclsHandler interface {
+	Handle(ShowAclsParams) middleware.Responder
+}
+
+// NewShowAcls creates a new http.Handler for the show acls operation
+func NewShowAcls(ctx *middleware.Context, handler ShowAclsHandler) *ShowAcls {
+	return &ShowAcls{Context: ctx, Handler: handler}
+}
+
+/*
+	ShowAcls swagger:route GET /acl list showAcls
+
+Show all access control lists
+*/
+type ShowAcls struct {
+	Context *middleware.Context
+	Handler ShowAclsHandler
+}
+
+func (o *ShowAcls) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewShowAclsParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
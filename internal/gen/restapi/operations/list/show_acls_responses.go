@@ -0,0 +1,107 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package list
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+// ShowAclsOKCode is the HTTP code returned for type ShowAclsOK
+const ShowAclsOKCode int = 200
+
+/*
+ShowAclsOK OK
+
+swagger:response showAclsOK
+*/
+type ShowAclsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.Acls `json:"body,omitempty"`
+}
+
+// NewShowAclsOK creates ShowAclsOK with default headers values
+func NewShowAclsOK() *ShowAclsOK {
+
+	return &ShowAclsOK{}
+}
+
+// WithPayload adds the payload to the show acls o k response
+func (o *ShowAclsOK) WithPayload(payload models.Acls) *ShowAclsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the show acls o k response
+func (o *ShowAclsOK) SetPayload(payload models.Acls) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ShowAclsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty map
+		payload = models.Acls{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// ShowAclsBadRequestCode is the HTTP code returned for type ShowAclsBadRequest
+const ShowAclsBadRequestCode int = 400
+
+/*
+ShowAclsBadRequest Bad request
+
+swagger:response showAclsBadRequest
+*/
+type ShowAclsBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewShowAclsBadRequest creates ShowAclsBadRequest with default headers values
+func NewShowAclsBadRequest() *ShowAclsBadRequest {
+
+	return &ShowAclsBadRequest{}
+}
+
+// WithPayload adds the payload to the show acls bad request response
+func (o *ShowAclsBadRequest) WithPayload(payload *models.Answer) *ShowAclsBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the show acls bad request response
+func (o *ShowAclsBadRequest) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ShowAclsBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package list
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ShowBlocklistsHandlerFunc turns a function with the right signature into a show blocklists handler
+type ShowBlocklistsHandlerFunc func(ShowBlocklistsParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ShowBlocklistsHandlerFunc) Handle(params ShowBlocklistsParams) middleware.Responder {
+	return fn(params)
+}
+
+// ShowBlocklistsHandler interface for that can handle valid show blocklists params
+type ShowBlocklistsHandler interface {
+	Handle(ShowBlocklistsParams) middleware.Responder
+}
+
+// NewShowBlocklists creates a new http.Handler for the show blocklists operation
+func NewShowBlocklists(ctx *middleware.Context, handler ShowBlocklistsHandler) *ShowBlocklists {
+	return &ShowBlocklists{Context: ctx, Handler: handler}
+}
+
+/*
+	ShowBlocklists swagger:route GET /blocklist list showBlocklists
+
+Show all block and allow lists with hit counts
+*/
+type ShowBlocklists struct {
+	Context *middleware.Context
+	Handler ShowBlocklistsHandler
+}
+
+func (o *ShowBlocklists) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewShowBlocklistsParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
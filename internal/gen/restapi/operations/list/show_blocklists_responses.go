@@ -0,0 +1,107 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package list
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+// ShowBlocklistsOKCode is the HTTP code returned for type ShowBlocklistsOK
+const ShowBlocklistsOKCode int = 200
+
+/*
+ShowBlocklistsOK OK
+
+swagger:response showBlocklistsOK
+*/
+type ShowBlocklistsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.Blocklists `json:"body,omitempty"`
+}
+
+// NewShowBlocklistsOK creates ShowBlocklistsOK with default headers values
+func NewShowBlocklistsOK() *ShowBlocklistsOK {
+
+	return &ShowBlocklistsOK{}
+}
+
+// WithPayload adds the payload to the show blocklists o k response
+func (o *ShowBlocklistsOK) WithPayload(payload models.Blocklists) *ShowBlocklistsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the show blocklists o k response
+func (o *ShowBlocklistsOK) SetPayload(payload models.Blocklists) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ShowBlocklistsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty map
+		payload = models.Blocklists{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// ShowBlocklistsBadRequestCode is the HTTP code returned for type ShowBlocklistsBadRequest
+const ShowBlocklistsBadRequestCode int = 400
+
+/*
+ShowBlocklistsBadRequest Bad request
+
+swagger:response showBlocklistsBadRequest
+*/
+type ShowBlocklistsBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewShowBlocklistsBadRequest creates ShowBlocklistsBadRequest with default headers values
+func NewShowBlocklistsBadRequest() *ShowBlocklistsBadRequest {
+
+	return &ShowBlocklistsBadRequest{}
+}
+
+// WithPayload adds the payload to the show blocklists bad request response
+func (o *ShowBlocklistsBadRequest) WithPayload(payload *models.Answer) *ShowBlocklistsBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the show blocklists bad request response
+func (o *ShowBlocklistsBadRequest) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ShowBlocklistsBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package list
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ShowDkimKeysHandlerFunc turns a function with the right signature into a show dkim keys handler
+type ShowDkimKeysHandlerFunc func(ShowDkimKeysParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ShowDkimKeysHandlerFunc) Handle(params ShowDkimKeysParams) middleware.Responder {
+	return fn(params)
+}
+
+// ShowDkimKeysHandler interface for that can handle valid show dkim keys params
+type ShowDkimKeysHandler interface {
+	Handle(ShowDkimKeysParams) middleware.Responder
+}
+
+// NewShowDkimKeys creates a new http.Handler for the show dkim keys operation
+func NewShowDkimKeys(ctx *middleware.Context, handler ShowDkimKeysHandler) *ShowDkimKeys {
+	return &ShowDkimKeys{Context: ctx, Handler: handler}
+}
+
+/*
+	ShowDkimKeys swagger:route GET /dns/{domain}/dkim list showDkimKeys
+
+Show DKIM keys of dns entry without private keys
+*/
+type ShowDkimKeys struct {
+	Context *middleware.Context
+	Handler ShowDkimKeysHandler
+}
+
+func (o *ShowDkimKeys) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewShowDkimKeysParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
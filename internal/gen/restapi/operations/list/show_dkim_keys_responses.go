@@ -0,0 +1,107 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package list
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+// ShowDkimKeysOKCode is the HTTP code returned for type ShowDkimKeysOK
+const ShowDkimKeysOKCode int = 200
+
+/*
+ShowDkimKeysOK OK
+
+swagger:response showDkimKeysOK
+*/
+type ShowDkimKeysOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.DkimKeys `json:"body,omitempty"`
+}
+
+// NewShowDkimKeysOK creates ShowDkimKeysOK with default headers values
+func NewShowDkimKeysOK() *ShowDkimKeysOK {
+
+	return &ShowDkimKeysOK{}
+}
+
+// WithPayload adds the payload to the show dkim keys o k response
+func (o *ShowDkimKeysOK) WithPayload(payload models.DkimKeys) *ShowDkimKeysOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the show dkim keys o k response
+func (o *ShowDkimKeysOK) SetPayload(payload models.DkimKeys) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ShowDkimKeysOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.DkimKeys{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// ShowDkimKeysBadRequestCode is the HTTP code returned for type ShowDkimKeysBadRequest
+const ShowDkimKeysBadRequestCode int = 400
+
+/*
+ShowDkimKeysBadRequest Bad request
+
+swagger:response showDkimKeysBadRequest
+*/
+type ShowDkimKeysBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewShowDkimKeysBadRequest creates ShowDkimKeysBadRequest with default headers values
+func NewShowDkimKeysBadRequest() *ShowDkimKeysBadRequest {
+
+	return &ShowDkimKeysBadRequest{}
+}
+
+// WithPayload adds the payload to the show dkim keys bad request response
+func (o *ShowDkimKeysBadRequest) WithPayload(payload *models.Answer) *ShowDkimKeysBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the show dkim keys bad request response
+func (o *ShowDkimKeysBadRequest) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ShowDkimKeysBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package list
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ShowHealthHandlerFunc turns a function with the right signature into a show health handler
+type ShowHealthHandlerFunc func(ShowHealthParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ShowHealthHandlerFunc) Handle(params ShowHealthParams) middleware.Responder {
+	return fn(params)
+}
+
+// ShowHealthHandler interface for that can handle valid show health params
+type ShowHealthHandler interface {
+	Handle(ShowHealthParams) middleware.Responder
+}
+
+// NewShowHealth creates a new http.Handler for the show health operation
+func NewShowHealth(ctx *middleware.Context, handler ShowHealthHandler) *ShowHealth {
+	return &ShowHealth{Context: ctx, Handler: handler}
+}
+
+/*
+	ShowHealth swagger:route GET /health list showHealth
+
+Show status of health checks of all dns entries
+*/
+type ShowHealth struct {
+	Context *middleware.Context
+	Handler ShowHealthHandler
+}
+
+func (o *ShowHealth) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewShowHealthParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,107 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package list
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+// ShowHealthOKCode is the HTTP code returned for type ShowHealthOK
+const ShowHealthOKCode int = 200
+
+/*
+ShowHealthOK OK
+
+swagger:response showHealthOK
+*/
+type ShowHealthOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.HealthStatuses `json:"body,omitempty"`
+}
+
+// NewShowHealthOK creates ShowHealthOK with default headers values
+func NewShowHealthOK() *ShowHealthOK {
+
+	return &ShowHealthOK{}
+}
+
+// WithPayload adds the payload to the show health o k response
+func (o *ShowHealthOK) WithPayload(payload models.HealthStatuses) *ShowHealthOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the show health o k response
+func (o *ShowHealthOK) SetPayload(payload models.HealthStatuses) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ShowHealthOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty map
+		payload = models.HealthStatuses{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// ShowHealthBadRequestCode is the HTTP code returned for type ShowHealthBadRequest
+const ShowHealthBadRequestCode int = 400
+
+/*
+ShowHealthBadRequest Bad request
+
+swagger:response showHealthBadRequest
+*/
+type ShowHealthBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewShowHealthBadRequest creates ShowHealthBadRequest with default headers values
+func NewShowHealthBadRequest() *ShowHealthBadRequest {
+
+	return &ShowHealthBadRequest{}
+}
+
+// WithPayload adds the payload to the show health bad request response
+func (o *ShowHealthBadRequest) WithPayload(payload *models.Answer) *ShowHealthBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the show health bad request response
+func (o *ShowHealthBadRequest) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ShowHealthBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package list
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ShowOverridesHandlerFunc turns a function with the right signature into a show overrides handler
+type ShowOverridesHandlerFunc func(ShowOverridesParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ShowOverridesHandlerFunc) Handle(params ShowOverridesParams) middleware.Responder {
+	return fn(params)
+}
+
+// ShowOverridesHandler interface for that can handle valid show overrides params
+type ShowOverridesHandler interface {
+	Handle(ShowOverridesParams) middleware.Responder
+}
+
+// NewShowOverrides creates a new http.Handler for the show overrides operation
+func NewShowOverrides(ctx *middleware.Context, handler ShowOverridesHandler) *ShowOverrides {
+	return &ShowOverrides{Context: ctx, Handler: handler}
+}
+
+/*
+	ShowOverrides swagger:route GET /override list showOverrides
+
+Show all static overrides of names
+*/
+type ShowOverrides struct {
+	Context *middleware.Context
+	Handler ShowOverridesHandler
+}
+
+func (o *ShowOverrides) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewShowOverridesParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
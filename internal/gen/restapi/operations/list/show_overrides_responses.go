@@ -0,0 +1,107 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package list
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+// ShowOverridesOKCode is the HTTP code returned for type ShowOverridesOK
+const ShowOverridesOKCode int = 200
+
+/*
+ShowOverridesOK OK
+
+swagger:response showOverridesOK
+*/
+type ShowOverridesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.Overrides `json:"body,omitempty"`
+}
+
+// NewShowOverridesOK creates ShowOverridesOK with default headers values
+func NewShowOverridesOK() *ShowOverridesOK {
+
+	return &ShowOverridesOK{}
+}
+
+// WithPayload adds the payload to the show overrides o k response
+func (o *ShowOverridesOK) WithPayload(payload models.Overrides) *ShowOverridesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the show overrides o k response
+func (o *ShowOverridesOK) SetPayload(payload models.Overrides) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ShowOverridesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty map
+		payload = models.Overrides{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// ShowOverridesBadRequestCode is the HTTP code returned for type ShowOverridesBadRequest
+const ShowOverridesBadRequestCode int = 400
+
+/*
+ShowOverridesBadRequest Bad request
+
+swagger:response showOverridesBadRequest
+*/
+type ShowOverridesBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewShowOverridesBadRequest creates ShowOverridesBadRequest with default headers values
+func NewShowOverridesBadRequest() *ShowOverridesBadRequest {
+
+	return &ShowOverridesBadRequest{}
+}
+
+// WithPayload adds the payload to the show overrides bad request response
+func (o *ShowOverridesBadRequest) WithPayload(payload *models.Answer) *ShowOverridesBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the show overrides bad request response
+func (o *ShowOverridesBadRequest) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ShowOverridesBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
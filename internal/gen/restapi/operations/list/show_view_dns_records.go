@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package list
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ShowViewDNSRecordsHandlerFunc turns a function with the right signature into a show view dns records handler
+type ShowViewDNSRecordsHandlerFunc func(ShowViewDNSRecordsParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ShowViewDNSRecordsHandlerFunc) Handle(params ShowViewDNSRecordsParams) middleware.Responder {
+	return fn(params)
+}
+
+// ShowViewDNSRecordsHandler interface for that can handle valid show view dns records params
+type ShowViewDNSRecordsHandler interface {
+	Handle(ShowViewDNSRecordsParams) middleware.Responder
+}
+
+// NewShowViewDNSRecords creates a new http.Handler for the show view dns records operation
+func NewShowViewDNSRecords(ctx *middleware.Context, handler ShowViewDNSRecordsHandler) *ShowViewDNSRecords {
+	return &ShowViewDNSRecords{Context: ctx, Handler: handler}
+}
+
+/*
+	ShowViewDNSRecords swagger:route GET /views/{view}/dns list showViewDnsRecords
+
+Show all dns records of view
+*/
+type ShowViewDNSRecords struct {
+	Context *middleware.Context
+	Handler ShowViewDNSRecordsHandler
+}
+
+func (o *ShowViewDNSRecords) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewShowViewDNSRecordsParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
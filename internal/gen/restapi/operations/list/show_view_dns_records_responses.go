@@ -0,0 +1,107 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package list
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+// ShowViewDNSRecordsOKCode is the HTTP code returned for type ShowViewDNSRecordsOK
+const ShowViewDNSRecordsOKCode int = 200
+
+/*
+ShowViewDNSRecordsOK OK
+
+swagger:response showViewDnsRecordsOK
+*/
+type ShowViewDNSRecordsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.DNSRecords `json:"body,omitempty"`
+}
+
+// NewShowViewDNSRecordsOK creates ShowViewDNSRecordsOK with default headers values
+func NewShowViewDNSRecordsOK() *ShowViewDNSRecordsOK {
+
+	return &ShowViewDNSRecordsOK{}
+}
+
+// WithPayload adds the payload to the show view Dns records o k response
+func (o *ShowViewDNSRecordsOK) WithPayload(payload models.DNSRecords) *ShowViewDNSRecordsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the show view Dns records o k response
+func (o *ShowViewDNSRecordsOK) SetPayload(payload models.DNSRecords) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ShowViewDNSRecordsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty map
+		payload = models.DNSRecords{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// ShowViewDNSRecordsBadRequestCode is the HTTP code returned for type ShowViewDNSRecordsBadRequest
+const ShowViewDNSRecordsBadRequestCode int = 400
+
+/*
+ShowViewDNSRecordsBadRequest Bad request
+
+swagger:response showViewDnsRecordsBadRequest
+*/
+type ShowViewDNSRecordsBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewShowViewDNSRecordsBadRequest creates ShowViewDNSRecordsBadRequest with default headers values
+func NewShowViewDNSRecordsBadRequest() *ShowViewDNSRecordsBadRequest {
+
+	return &ShowViewDNSRecordsBadRequest{}
+}
+
+// WithPayload adds the payload to the show view Dns records bad request response
+func (o *ShowViewDNSRecordsBadRequest) WithPayload(payload *models.Answer) *ShowViewDNSRecordsBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the show view Dns records bad request response
+func (o *ShowViewDNSRecordsBadRequest) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ShowViewDNSRecordsBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package list
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ShowViewsHandlerFunc turns a function with the right signature into a show views handler
+type ShowViewsHandlerFunc func(ShowViewsParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ShowViewsHandlerFunc) Handle(params ShowViewsParams) middleware.Responder {
+	return fn(params)
+}
+
+// ShowViewsHandler interface for that can handle valid show views params
+type ShowViewsHandler interface {
+	Handle(ShowViewsParams) middleware.Responder
+}
+
+// NewShowViews creates a new http.Handler for the show views operation
+func NewShowViews(ctx *middleware.Context, handler ShowViewsHandler) *ShowViews {
+	return &ShowViews{Context: ctx, Handler: handler}
+}
+
+/*
+	ShowViews swagger:route GET /views list showViews
+
+Show all views
+*/
+type ShowViews struct {
+	Context *middleware.Context
+	Handler ShowViewsHandler
+}
+
+func (o *ShowViews) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewShowViewsParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,107 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package list
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+// ShowViewsOKCode is the HTTP code returned for type ShowViewsOK
+const ShowViewsOKCode int = 200
+
+/*
+ShowViewsOK OK
+
+swagger:response showViewsOK
+*/
+type ShowViewsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.Views `json:"body,omitempty"`
+}
+
+// NewShowViewsOK creates ShowViewsOK with default headers values
+func NewShowViewsOK() *ShowViewsOK {
+
+	return &ShowViewsOK{}
+}
+
+// WithPayload adds the payload to the show views o k response
+func (o *ShowViewsOK) WithPayload(payload models.Views) *ShowViewsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the show views o k response
+func (o *ShowViewsOK) SetPayload(payload models.Views) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ShowViewsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty map
+		payload = models.Views{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// ShowViewsBadRequestCode is the HTTP code returned for type ShowViewsBadRequest
+const ShowViewsBadRequestCode int = 400
+
+/*
+ShowViewsBadRequest Bad request
+
+swagger:response showViewsBadRequest
+*/
+type ShowViewsBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewShowViewsBadRequest creates ShowViewsBadRequest with default headers values
+func NewShowViewsBadRequest() *ShowViewsBadRequest {
+
+	return &ShowViewsBadRequest{}
+}
+
+// WithPayload adds the payload to the show views bad request response
+func (o *ShowViewsBadRequest) WithPayload(payload *models.Answer) *ShowViewsBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the show views bad request response
+func (o *ShowViewsBadRequest) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ShowViewsBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
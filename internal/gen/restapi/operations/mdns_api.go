@@ -48,21 +48,120 @@ func NewMdnsAPI(spec *loads.Document) *MdnsAPI {
 
 		JSONProducer: runtime.JSONProducer(),
 
+		AddAddACLHandler: add.AddACLHandlerFunc(func(params add.AddACLParams) middleware.Responder {
+			return middleware.NotImplemented("operation add.AddACL has not yet been implemented")
+		}),
+		AddAddBlocklistHandler: add.AddBlocklistHandlerFunc(func(params add.AddBlocklistParams) middleware.Responder {
+			return middleware.NotImplemented("operation add.AddBlocklist has not yet been implemented")
+		}),
+		AddAddDkimKeyHandler: add.AddDkimKeyHandlerFunc(func(params add.AddDkimKeyParams) middleware.Responder {
+			return middleware.NotImplemented("operation add.AddDkimKey has not yet been implemented")
+		}),
 		AddAddDNSEntryHandler: add.AddDNSEntryHandlerFunc(func(params add.AddDNSEntryParams) middleware.Responder {
 			return middleware.NotImplemented("operation add.AddDNSEntry has not yet been implemented")
 		}),
+		AddAddOverrideHandler: add.AddOverrideHandlerFunc(func(params add.AddOverrideParams) middleware.Responder {
+			return middleware.NotImplemented("operation add.AddOverride has not yet been implemented")
+		}),
+		AddAddViewHandler: add.AddViewHandlerFunc(func(params add.AddViewParams) middleware.Responder {
+			return middleware.NotImplemented("operation add.AddView has not yet been implemented")
+		}),
+		AddAddViewDNSEntryHandler: add.AddViewDNSEntryHandlerFunc(func(params add.AddViewDNSEntryParams) middleware.Responder {
+			return middleware.NotImplemented("operation add.AddViewDNSEntry has not yet been implemented")
+		}),
+		DeleteDeleteACLHandler: delete.DeleteACLHandlerFunc(func(params delete.DeleteACLParams) middleware.Responder {
+			return middleware.NotImplemented("operation delete.DeleteACL has not yet been implemented")
+		}),
+		DeleteDeleteBlocklistHandler: delete.DeleteBlocklistHandlerFunc(func(params delete.DeleteBlocklistParams) middleware.Responder {
+			return middleware.NotImplemented("operation delete.DeleteBlocklist has not yet been implemented")
+		}),
 		DeleteDeleteDNSEntryHandler: delete.DeleteDNSEntryHandlerFunc(func(params delete.DeleteDNSEntryParams) middleware.Responder {
 			return middleware.NotImplemented("operation delete.DeleteDNSEntry has not yet been implemented")
 		}),
+		DeleteDeleteOverrideHandler: delete.DeleteOverrideHandlerFunc(func(params delete.DeleteOverrideParams) middleware.Responder {
+			return middleware.NotImplemented("operation delete.DeleteOverride has not yet been implemented")
+		}),
+		DeleteDeleteViewHandler: delete.DeleteViewHandlerFunc(func(params delete.DeleteViewParams) middleware.Responder {
+			return middleware.NotImplemented("operation delete.DeleteView has not yet been implemented")
+		}),
+		DeleteDeleteViewDNSEntryHandler: delete.DeleteViewDNSEntryHandlerFunc(func(params delete.DeleteViewDNSEntryParams) middleware.Responder {
+			return middleware.NotImplemented("operation delete.DeleteViewDNSEntry has not yet been implemented")
+		}),
+		ShowExportDkimKeyHandler: show.ExportDkimKeyHandlerFunc(func(params show.ExportDkimKeyParams) middleware.Responder {
+			return middleware.NotImplemented("operation show.ExportDkimKey has not yet been implemented")
+		}),
+		ShowListOneACLHandler: show.ListOneACLHandlerFunc(func(params show.ListOneACLParams) middleware.Responder {
+			return middleware.NotImplemented("operation show.ListOneACL has not yet been implemented")
+		}),
+		ShowListOneBlocklistHandler: show.ListOneBlocklistHandlerFunc(func(params show.ListOneBlocklistParams) middleware.Responder {
+			return middleware.NotImplemented("operation show.ListOneBlocklist has not yet been implemented")
+		}),
 		ShowListOneDNSEntryHandler: show.ListOneDNSEntryHandlerFunc(func(params show.ListOneDNSEntryParams) middleware.Responder {
 			return middleware.NotImplemented("operation show.ListOneDNSEntry has not yet been implemented")
 		}),
+		ShowListOneHealthHandler: show.ListOneHealthHandlerFunc(func(params show.ListOneHealthParams) middleware.Responder {
+			return middleware.NotImplemented("operation show.ListOneHealth has not yet been implemented")
+		}),
+		ShowListOneOverrideHandler: show.ListOneOverrideHandlerFunc(func(params show.ListOneOverrideParams) middleware.Responder {
+			return middleware.NotImplemented("operation show.ListOneOverride has not yet been implemented")
+		}),
+		ShowListOneViewHandler: show.ListOneViewHandlerFunc(func(params show.ListOneViewParams) middleware.Responder {
+			return middleware.NotImplemented("operation show.ListOneView has not yet been implemented")
+		}),
+		ShowListOneViewDNSEntryHandler: show.ListOneViewDNSEntryHandlerFunc(func(params show.ListOneViewDNSEntryParams) middleware.Responder {
+			return middleware.NotImplemented("operation show.ListOneViewDNSEntry has not yet been implemented")
+		}),
+		ShowPreviewDNSEntryHandler: show.PreviewDNSEntryHandlerFunc(func(params show.PreviewDNSEntryParams) middleware.Responder {
+			return middleware.NotImplemented("operation show.PreviewDNSEntry has not yet been implemented")
+		}),
+		DeleteRevokeDkimKeyHandler: delete.RevokeDkimKeyHandlerFunc(func(params delete.RevokeDkimKeyParams) middleware.Responder {
+			return middleware.NotImplemented("operation delete.RevokeDkimKey has not yet been implemented")
+		}),
+		UpdateRotateDkimKeyHandler: update.RotateDkimKeyHandlerFunc(func(params update.RotateDkimKeyParams) middleware.Responder {
+			return middleware.NotImplemented("operation update.RotateDkimKey has not yet been implemented")
+		}),
+		ListShowAclsHandler: list.ShowAclsHandlerFunc(func(params list.ShowAclsParams) middleware.Responder {
+			return middleware.NotImplemented("operation list.ShowAcls has not yet been implemented")
+		}),
+		ListShowBlocklistsHandler: list.ShowBlocklistsHandlerFunc(func(params list.ShowBlocklistsParams) middleware.Responder {
+			return middleware.NotImplemented("operation list.ShowBlocklists has not yet been implemented")
+		}),
+		ListShowDkimKeysHandler: list.ShowDkimKeysHandlerFunc(func(params list.ShowDkimKeysParams) middleware.Responder {
+			return middleware.NotImplemented("operation list.ShowDkimKeys has not yet been implemented")
+		}),
 		ListShowDNSRecordsHandler: list.ShowDNSRecordsHandlerFunc(func(params list.ShowDNSRecordsParams) middleware.Responder {
 			return middleware.NotImplemented("operation list.ShowDNSRecords has not yet been implemented")
 		}),
+		ListShowHealthHandler: list.ShowHealthHandlerFunc(func(params list.ShowHealthParams) middleware.Responder {
+			return middleware.NotImplemented("operation list.ShowHealth has not yet been implemented")
+		}),
+		ShowShowMailPolicyHandler: show.ShowMailPolicyHandlerFunc(func(params show.ShowMailPolicyParams) middleware.Responder {
+			return middleware.NotImplemented("operation show.ShowMailPolicy has not yet been implemented")
+		}),
+		ListShowOverridesHandler: list.ShowOverridesHandlerFunc(func(params list.ShowOverridesParams) middleware.Responder {
+			return middleware.NotImplemented("operation list.ShowOverrides has not yet been implemented")
+		}),
+		ShowShowRrlHandler: show.ShowRrlHandlerFunc(func(params show.ShowRrlParams) middleware.Responder {
+			return middleware.NotImplemented("operation show.ShowRrl has not yet been implemented")
+		}),
+		ListShowViewDNSRecordsHandler: list.ShowViewDNSRecordsHandlerFunc(func(params list.ShowViewDNSRecordsParams) middleware.Responder {
+			return middleware.NotImplemented("operation list.ShowViewDNSRecords has not yet been implemented")
+		}),
+		ListShowViewsHandler: list.ShowViewsHandlerFunc(func(params list.ShowViewsParams) middleware.Responder {
+			return middleware.NotImplemented("operation list.ShowViews has not yet been implemented")
+		}),
 		UpdateUpdateDNSEntryHandler: update.UpdateDNSEntryHandlerFunc(func(params update.UpdateDNSEntryParams) middleware.Responder {
 			return middleware.NotImplemented("operation update.UpdateDNSEntry has not yet been implemented")
 		}),
+		UpdateUpdateMailPolicyHandler: update.UpdateMailPolicyHandlerFunc(func(params update.UpdateMailPolicyParams) middleware.Responder {
+			return middleware.NotImplemented("operation update.UpdateMailPolicy has not yet been implemented")
+		}),
+		UpdateUpdateRrlHandler: update.UpdateRrlHandlerFunc(func(params update.UpdateRrlParams) middleware.Responder {
+			return middleware.NotImplemented("operation update.UpdateRrl has not yet been implemented")
+		}),
+		UpdateUpdateViewDNSEntryHandler: update.UpdateViewDNSEntryHandlerFunc(func(params update.UpdateViewDNSEntryParams) middleware.Responder {
+			return middleware.NotImplemented("operation update.UpdateViewDNSEntry has not yet been implemented")
+		}),
 	}
 }
 
@@ -99,16 +198,82 @@ type MdnsAPI struct {
 	//   - application/json
 	JSONProducer runtime.Producer
 
+	// AddAddACLHandler sets the operation handler for the add acl operation
+	AddAddACLHandler add.AddACLHandler
+	// AddAddBlocklistHandler sets the operation handler for the add blocklist operation
+	AddAddBlocklistHandler add.AddBlocklistHandler
+	// AddAddDkimKeyHandler sets the operation handler for the add dkim key operation
+	AddAddDkimKeyHandler add.AddDkimKeyHandler
 	// AddAddDNSEntryHandler sets the operation handler for the add dns entry operation
 	AddAddDNSEntryHandler add.AddDNSEntryHandler
+	// AddAddOverrideHandler sets the operation handler for the add override operation
+	AddAddOverrideHandler add.AddOverrideHandler
+	// AddAddViewHandler sets the operation handler for the add view operation
+	AddAddViewHandler add.AddViewHandler
+	// AddAddViewDNSEntryHandler sets the operation handler for the add view dns entry operation
+	AddAddViewDNSEntryHandler add.AddViewDNSEntryHandler
+	// DeleteDeleteACLHandler sets the operation handler for the delete acl operation
+	DeleteDeleteACLHandler delete.DeleteACLHandler
+	// DeleteDeleteBlocklistHandler sets the operation handler for the delete blocklist operation
+	DeleteDeleteBlocklistHandler delete.DeleteBlocklistHandler
 	// DeleteDeleteDNSEntryHandler sets the operation handler for the delete dns entry operation
 	DeleteDeleteDNSEntryHandler delete.DeleteDNSEntryHandler
+	// DeleteDeleteOverrideHandler sets the operation handler for the delete override operation
+	DeleteDeleteOverrideHandler delete.DeleteOverrideHandler
+	// DeleteDeleteViewHandler sets the operation handler for the delete view operation
+	DeleteDeleteViewHandler delete.DeleteViewHandler
+	// DeleteDeleteViewDNSEntryHandler sets the operation handler for the delete view dns entry operation
+	DeleteDeleteViewDNSEntryHandler delete.DeleteViewDNSEntryHandler
+	// ShowExportDkimKeyHandler sets the operation handler for the export dkim key operation
+	ShowExportDkimKeyHandler show.ExportDkimKeyHandler
+	// ShowListOneACLHandler sets the operation handler for the list one acl operation
+	ShowListOneACLHandler show.ListOneACLHandler
+	// ShowListOneBlocklistHandler sets the operation handler for the list one blocklist operation
+	ShowListOneBlocklistHandler show.ListOneBlocklistHandler
 	// ShowListOneDNSEntryHandler sets the operation handler for the list one dns entry operation
 	ShowListOneDNSEntryHandler show.ListOneDNSEntryHandler
+	// ShowListOneHealthHandler sets the operation handler for the list one health operation
+	ShowListOneHealthHandler show.ListOneHealthHandler
+	// ShowListOneOverrideHandler sets the operation handler for the list one override operation
+	ShowListOneOverrideHandler show.ListOneOverrideHandler
+	// ShowListOneViewHandler sets the operation handler for the list one view operation
+	ShowListOneViewHandler show.ListOneViewHandler
+	// ShowListOneViewDNSEntryHandler sets the operation handler for the list one view dns entry operation
+	ShowListOneViewDNSEntryHandler show.ListOneViewDNSEntryHandler
+	// ShowPreviewDNSEntryHandler sets the operation handler for the preview dns entry operation
+	ShowPreviewDNSEntryHandler show.PreviewDNSEntryHandler
+	// DeleteRevokeDkimKeyHandler sets the operation handler for the revoke dkim key operation
+	DeleteRevokeDkimKeyHandler delete.RevokeDkimKeyHandler
+	// UpdateRotateDkimKeyHandler sets the operation handler for the rotate dkim key operation
+	UpdateRotateDkimKeyHandler update.RotateDkimKeyHandler
+	// ListShowAclsHandler sets the operation handler for the show acls operation
+	ListShowAclsHandler list.ShowAclsHandler
+	// ListShowBlocklistsHandler sets the operation handler for the show blocklists operation
+	ListShowBlocklistsHandler list.ShowBlocklistsHandler
+	// ListShowDkimKeysHandler sets the operation handler for the show dkim keys operation
+	ListShowDkimKeysHandler list.ShowDkimKeysHandler
 	// ListShowDNSRecordsHandler sets the operation handler for the show dns records operation
 	ListShowDNSRecordsHandler list.ShowDNSRecordsHandler
+	// ListShowHealthHandler sets the operation handler for the show health operation
+	ListShowHealthHandler list.ShowHealthHandler
+	// ShowShowMailPolicyHandler sets the operation handler for the show mail policy operation
+	ShowShowMailPolicyHandler show.ShowMailPolicyHandler
+	// ListShowOverridesHandler sets the operation handler for the show overrides operation
+	ListShowOverridesHandler list.ShowOverridesHandler
+	// ShowShowRrlHandler sets the operation handler for the show rrl operation
+	ShowShowRrlHandler show.ShowRrlHandler
+	// ListShowViewDNSRecordsHandler sets the operation handler for the show view dns records operation
+	ListShowViewDNSRecordsHandler list.ShowViewDNSRecordsHandler
+	// ListShowViewsHandler sets the operation handler for the show views operation
+	ListShowViewsHandler list.ShowViewsHandler
 	// UpdateUpdateDNSEntryHandler sets the operation handler for the update dns entry operation
 	UpdateUpdateDNSEntryHandler update.UpdateDNSEntryHandler
+	// UpdateUpdateMailPolicyHandler sets the operation handler for the update mail policy operation
+	UpdateUpdateMailPolicyHandler update.UpdateMailPolicyHandler
+	// UpdateUpdateRrlHandler sets the operation handler for the update rrl operation
+	UpdateUpdateRrlHandler update.UpdateRrlHandler
+	// UpdateUpdateViewDNSEntryHandler sets the operation handler for the update view dns entry operation
+	UpdateUpdateViewDNSEntryHandler update.UpdateViewDNSEntryHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -186,21 +351,120 @@ func (o *MdnsAPI) Validate() error {
 		unregistered = append(unregistered, "JSONProducer")
 	}
 
+	if o.AddAddACLHandler == nil {
+		unregistered = append(unregistered, "add.AddACLHandler")
+	}
+	if o.AddAddBlocklistHandler == nil {
+		unregistered = append(unregistered, "add.AddBlocklistHandler")
+	}
+	if o.AddAddDkimKeyHandler == nil {
+		unregistered = append(unregistered, "add.AddDkimKeyHandler")
+	}
 	if o.AddAddDNSEntryHandler == nil {
 		unregistered = append(unregistered, "add.AddDNSEntryHandler")
 	}
+	if o.AddAddOverrideHandler == nil {
+		unregistered = append(unregistered, "add.AddOverrideHandler")
+	}
+	if o.AddAddViewHandler == nil {
+		unregistered = append(unregistered, "add.AddViewHandler")
+	}
+	if o.AddAddViewDNSEntryHandler == nil {
+		unregistered = append(unregistered, "add.AddViewDNSEntryHandler")
+	}
+	if o.DeleteDeleteACLHandler == nil {
+		unregistered = append(unregistered, "delete.DeleteACLHandler")
+	}
+	if o.DeleteDeleteBlocklistHandler == nil {
+		unregistered = append(unregistered, "delete.DeleteBlocklistHandler")
+	}
 	if o.DeleteDeleteDNSEntryHandler == nil {
 		unregistered = append(unregistered, "delete.DeleteDNSEntryHandler")
 	}
+	if o.DeleteDeleteOverrideHandler == nil {
+		unregistered = append(unregistered, "delete.DeleteOverrideHandler")
+	}
+	if o.DeleteDeleteViewHandler == nil {
+		unregistered = append(unregistered, "delete.DeleteViewHandler")
+	}
+	if o.DeleteDeleteViewDNSEntryHandler == nil {
+		unregistered = append(unregistered, "delete.DeleteViewDNSEntryHandler")
+	}
+	if o.ShowExportDkimKeyHandler == nil {
+		unregistered = append(unregistered, "show.ExportDkimKeyHandler")
+	}
+	if o.ShowListOneACLHandler == nil {
+		unregistered = append(unregistered, "show.ListOneACLHandler")
+	}
+	if o.ShowListOneBlocklistHandler == nil {
+		unregistered = append(unregistered, "show.ListOneBlocklistHandler")
+	}
 	if o.ShowListOneDNSEntryHandler == nil {
 		unregistered = append(unregistered, "show.ListOneDNSEntryHandler")
 	}
+	if o.ShowListOneHealthHandler == nil {
+		unregistered = append(unregistered, "show.ListOneHealthHandler")
+	}
+	if o.ShowListOneOverrideHandler == nil {
+		unregistered = append(unregistered, "show.ListOneOverrideHandler")
+	}
+	if o.ShowListOneViewHandler == nil {
+		unregistered = append(unregistered, "show.ListOneViewHandler")
+	}
+	if o.ShowListOneViewDNSEntryHandler == nil {
+		unregistered = append(unregistered, "show.ListOneViewDNSEntryHandler")
+	}
+	if o.ShowPreviewDNSEntryHandler == nil {
+		unregistered = append(unregistered, "show.PreviewDNSEntryHandler")
+	}
+	if o.DeleteRevokeDkimKeyHandler == nil {
+		unregistered = append(unregistered, "delete.RevokeDkimKeyHandler")
+	}
+	if o.UpdateRotateDkimKeyHandler == nil {
+		unregistered = append(unregistered, "update.RotateDkimKeyHandler")
+	}
+	if o.ListShowAclsHandler == nil {
+		unregistered = append(unregistered, "list.ShowAclsHandler")
+	}
+	if o.ListShowBlocklistsHandler == nil {
+		unregistered = append(unregistered, "list.ShowBlocklistsHandler")
+	}
+	if o.ListShowDkimKeysHandler == nil {
+		unregistered = append(unregistered, "list.ShowDkimKeysHandler")
+	}
 	if o.ListShowDNSRecordsHandler == nil {
 		unregistered = append(unregistered, "list.ShowDNSRecordsHandler")
 	}
+	if o.ListShowHealthHandler == nil {
+		unregistered = append(unregistered, "list.ShowHealthHandler")
+	}
+	if o.ShowShowMailPolicyHandler == nil {
+		unregistered = append(unregistered, "show.ShowMailPolicyHandler")
+	}
+	if o.ListShowOverridesHandler == nil {
+		unregistered = append(unregistered, "list.ShowOverridesHandler")
+	}
+	if o.ShowShowRrlHandler == nil {
+		unregistered = append(unregistered, "show.ShowRrlHandler")
+	}
+	if o.ListShowViewDNSRecordsHandler == nil {
+		unregistered = append(unregistered, "list.ShowViewDNSRecordsHandler")
+	}
+	if o.ListShowViewsHandler == nil {
+		unregistered = append(unregistered, "list.ShowViewsHandler")
+	}
 	if o.UpdateUpdateDNSEntryHandler == nil {
 		unregistered = append(unregistered, "update.UpdateDNSEntryHandler")
 	}
+	if o.UpdateUpdateMailPolicyHandler == nil {
+		unregistered = append(unregistered, "update.UpdateMailPolicyHandler")
+	}
+	if o.UpdateUpdateRrlHandler == nil {
+		unregistered = append(unregistered, "update.UpdateRrlHandler")
+	}
+	if o.UpdateUpdateViewDNSEntryHandler == nil {
+		unregistered = append(unregistered, "update.UpdateViewDNSEntryHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -289,14 +553,70 @@ func (o *MdnsAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/acl"] = add.NewAddACL(o.context, o.AddAddACLHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/blocklist"] = add.NewAddBlocklist(o.context, o.AddAddBlocklistHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/dns/{domain}/dkim"] = add.NewAddDkimKey(o.context, o.AddAddDkimKeyHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/dns"] = add.NewAddDNSEntry(o.context, o.AddAddDNSEntryHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/override"] = add.NewAddOverride(o.context, o.AddAddOverrideHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/views"] = add.NewAddView(o.context, o.AddAddViewHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/views/{view}/dns"] = add.NewAddViewDNSEntry(o.context, o.AddAddViewDNSEntryHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/acl"] = delete.NewDeleteACL(o.context, o.DeleteDeleteACLHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/blocklist"] = delete.NewDeleteBlocklist(o.context, o.DeleteDeleteBlocklistHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
 	o.handlers["DELETE"]["/dns"] = delete.NewDeleteDNSEntry(o.context, o.DeleteDeleteDNSEntryHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/override"] = delete.NewDeleteOverride(o.context, o.DeleteDeleteOverrideHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/views"] = delete.NewDeleteView(o.context, o.DeleteDeleteViewHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/views/{view}/dns"] = delete.NewDeleteViewDNSEntry(o.context, o.DeleteDeleteViewDNSEntryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/dns/{domain}/dkim/{selector}/pem"] = show.NewExportDkimKey(o.context, o.ShowExportDkimKeyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/acl/{name}"] = show.NewListOneACL(o.context, o.ShowListOneACLHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/blocklist/{name}"] = show.NewListOneBlocklist(o.context, o.ShowListOneBlocklistHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -304,11 +624,87 @@ func (o *MdnsAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/health/{domain}"] = show.NewListOneHealth(o.context, o.ShowListOneHealthHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/override/{name}"] = show.NewListOneOverride(o.context, o.ShowListOneOverrideHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/views/{view}"] = show.NewListOneView(o.context, o.ShowListOneViewHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/views/{view}/dns/{domain}"] = show.NewListOneViewDNSEntry(o.context, o.ShowListOneViewDNSEntryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/dns/{domain}/preview"] = show.NewPreviewDNSEntry(o.context, o.ShowPreviewDNSEntryHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/dns/{domain}/dkim"] = delete.NewRevokeDkimKey(o.context, o.DeleteRevokeDkimKeyHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/dns/{domain}/dkim"] = update.NewRotateDkimKey(o.context, o.UpdateRotateDkimKeyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/acl"] = list.NewShowAcls(o.context, o.ListShowAclsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/blocklist"] = list.NewShowBlocklists(o.context, o.ListShowBlocklistsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/dns/{domain}/dkim"] = list.NewShowDkimKeys(o.context, o.ListShowDkimKeysHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/dns"] = list.NewShowDNSRecords(o.context, o.ListShowDNSRecordsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/health"] = list.NewShowHealth(o.context, o.ListShowHealthHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/dns/{domain}/mail"] = show.NewShowMailPolicy(o.context, o.ShowShowMailPolicyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/override"] = list.NewShowOverrides(o.context, o.ListShowOverridesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/rrl"] = show.NewShowRrl(o.context, o.ShowShowRrlHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/views/{view}/dns"] = list.NewShowViewDNSRecords(o.context, o.ListShowViewDNSRecordsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/views"] = list.NewShowViews(o.context, o.ListShowViewsHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/dns"] = update.NewUpdateDNSEntry(o.context, o.UpdateUpdateDNSEntryHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/dns/{domain}/mail"] = update.NewUpdateMailPolicy(o.context, o.UpdateUpdateMailPolicyHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/rrl"] = update.NewUpdateRrl(o.context, o.UpdateUpdateRrlHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/views/{view}/dns"] = update.NewUpdateViewDNSEntry(o.context, o.UpdateUpdateViewDNSEntryHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
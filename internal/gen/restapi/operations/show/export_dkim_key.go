@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package show
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ExportDkimKeyHandlerFunc turns a function with the right signature into a export dkim key handler
+type ExportDkimKeyHandlerFunc func(ExportDkimKeyParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ExportDkimKeyHandlerFunc) Handle(params ExportDkimKeyParams) middleware.Responder {
+	return fn(params)
+}
+
+// ExportDkimKeyHandler interface for that can handle valid export dkim key params
+type ExportDkimKeyHandler interface {
+	Handle(ExportDkimKeyParams) middleware.Responder
+}
+
+// NewExportDkimKey creates a new http.Handler for the export dkim key operation
+func NewExportDkimKey(ctx *middleware.Context, handler ExportDkimKeyHandler) *ExportDkimKey {
+	return &ExportDkimKey{Context: ctx, Handler: handler}
+}
+
+/*
+	ExportDkimKey swagger:route GET /dns/{domain}/dkim/{selector}/pem show exportDkimKey
+
+Export private DKIM key in PEM format
+*/
+type ExportDkimKey struct {
+	Context *middleware.Context
+	Handler ExportDkimKeyHandler
+}
+
+func (o *ExportDkimKey) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewExportDkimKeyParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,125 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package show
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewExportDkimKeyParams creates a new ExportDkimKeyParams object
+//
+// There are no default values defined in the spec.
+func NewExportDkimKeyParams() ExportDkimKeyParams {
+
+	return ExportDkimKeyParams{}
+}
+
+// ExportDkimKeyParams contains all the bound params for the export dkim key operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters export_dkim_key
+type ExportDkimKeyParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  Required: true
+	  In: path
+	*/
+	Domain string
+	/*
+	  Required: true
+	  In: path
+	*/
+	Selector string
+	/*name of view of zone, default zones without
+	  In: query
+	*/
+	View *string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewExportDkimKeyParams() beforehand.
+func (o *ExportDkimKeyParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	rDomain, rhkDomain, _ := route.Params.GetOK("domain")
+	if err := o.bindDomain(rDomain, rhkDomain, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rSelector, rhkSelector, _ := route.Params.GetOK("selector")
+	if err := o.bindSelector(rSelector, rhkSelector, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qView, qhkView, _ := qs.GetOK("view")
+	if err := o.bindView(qView, qhkView, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindDomain binds and validates parameter Domain from path.
+func (o *ExportDkimKeyParams) bindDomain(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.Domain = raw
+
+	return nil
+}
+
+// bindSelector binds and validates parameter Selector from path.
+func (o *ExportDkimKeyParams) bindSelector(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.Selector = raw
+
+	return nil
+}
+
+// bindView binds and validates parameter View from query.
+func (o *ExportDkimKeyParams) bindView(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.View = &raw
+
+	return nil
+}
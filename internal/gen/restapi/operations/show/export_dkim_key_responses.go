@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package show
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+// ExportDkimKeyOKCode is the HTTP code returned for type ExportDkimKeyOK
+const ExportDkimKeyOKCode int = 200
+
+/*
+ExportDkimKeyOK OK
+
+swagger:response exportDkimKeyOK
+*/
+type ExportDkimKeyOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.DkimPem `json:"body,omitempty"`
+}
+
+// NewExportDkimKeyOK creates ExportDkimKeyOK with default headers values
+func NewExportDkimKeyOK() *ExportDkimKeyOK {
+
+	return &ExportDkimKeyOK{}
+}
+
+// WithPayload adds the payload to the export dkim key o k response
+func (o *ExportDkimKeyOK) WithPayload(payload *models.DkimPem) *ExportDkimKeyOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the export dkim key o k response
+func (o *ExportDkimKeyOK) SetPayload(payload *models.DkimPem) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ExportDkimKeyOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ExportDkimKeyBadRequestCode is the HTTP code returned for type ExportDkimKeyBadRequest
+const ExportDkimKeyBadRequestCode int = 400
+
+/*
+ExportDkimKeyBadRequest Bad request
+
+swagger:response exportDkimKeyBadRequest
+*/
+type ExportDkimKeyBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewExportDkimKeyBadRequest creates ExportDkimKeyBadRequest with default headers values
+func NewExportDkimKeyBadRequest() *ExportDkimKeyBadRequest {
+
+	return &ExportDkimKeyBadRequest{}
+}
+
+// WithPayload adds the payload to the export dkim key bad request response
+func (o *ExportDkimKeyBadRequest) WithPayload(payload *models.Answer) *ExportDkimKeyBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the export dkim key bad request response
+func (o *ExportDkimKeyBadRequest) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ExportDkimKeyBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
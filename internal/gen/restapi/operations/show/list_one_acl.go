@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package show
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ListOneACLHandlerFunc turns a function with the right signature into a list one acl handler
+type ListOneACLHandlerFunc func(ListOneACLParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListOneACLHandlerFunc) Handle(params ListOneACLParams) middleware.Responder {
+	return fn(params)
+}
+
+// ListOneACLHandler interface for that can handle valid list one acl params
+type ListOneACLHandler interface {
+	Handle(ListOneACLParams) middleware.Responder
+}
+
+// NewListOneACL creates a new http.Handler for the list one acl operation
+func NewListOneACL(ctx *middleware.Context, handler ListOneACLHandler) *ListOneACL {
+	return &ListOneACL{Context: ctx, Handler: handler}
+}
+
+/*
+	ListOneACL swagger:route GET /acl/{name} show listOneAcl
+
+List one access control list
+*/
+type ListOneACL struct {
+	Context *middleware.Context
+	Handler ListOneACLHandler
+}
+
+func (o *ListOneACL) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListOneACLParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
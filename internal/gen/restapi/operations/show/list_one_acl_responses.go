@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package show
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+// ListOneACLOKCode is the HTTP code returned for type ListOneACLOK
+const ListOneACLOKCode int = 200
+
+/*
+ListOneACLOK OK
+
+swagger:response listOneAclOK
+*/
+type ListOneACLOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ACL `json:"body,omitempty"`
+}
+
+// NewListOneACLOK creates ListOneACLOK with default headers values
+func NewListOneACLOK() *ListOneACLOK {
+
+	return &ListOneACLOK{}
+}
+
+// WithPayload adds the payload to the list one Acl o k response
+func (o *ListOneACLOK) WithPayload(payload *models.ACL) *ListOneACLOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list one Acl o k response
+func (o *ListOneACLOK) SetPayload(payload *models.ACL) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListOneACLOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListOneACLBadRequestCode is the HTTP code returned for type ListOneACLBadRequest
+const ListOneACLBadRequestCode int = 400
+
+/*
+ListOneACLBadRequest Bad request
+
+swagger:response listOneAclBadRequest
+*/
+type ListOneACLBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewListOneACLBadRequest creates ListOneACLBadRequest with default headers values
+func NewListOneACLBadRequest() *ListOneACLBadRequest {
+
+	return &ListOneACLBadRequest{}
+}
+
+// WithPayload adds the payload to the list one Acl bad request response
+func (o *ListOneACLBadRequest) WithPayload(payload *models.Answer) *ListOneACLBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list one Acl bad request response
+func (o *ListOneACLBadRequest) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListOneACLBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package show
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ListOneBlocklistHandlerFunc turns a function with the right signature into a list one blocklist handler
+type ListOneBlocklistHandlerFunc func(ListOneBlocklistParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListOneBlocklistHandlerFunc) Handle(params ListOneBlocklistParams) middleware.Responder {
+	return fn(params)
+}
+
+// ListOneBlocklistHandler interface for that can handle valid list one blocklist params
+type ListOneBlocklistHandler interface {
+	Handle(ListOneBlocklistParams) middleware.Responder
+}
+
+// NewListOneBlocklist creates a new http.Handler for the list one blocklist operation
+func NewListOneBlocklist(ctx *middleware.Context, handler ListOneBlocklistHandler) *ListOneBlocklist {
+	return &ListOneBlocklist{Context: ctx, Handler: handler}
+}
+
+/*
+	ListOneBlocklist swagger:route GET /blocklist/{name} show listOneBlocklist
+
+List one block list
+*/
+type ListOneBlocklist struct {
+	Context *middleware.Context
+	Handler ListOneBlocklistHandler
+}
+
+func (o *ListOneBlocklist) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListOneBlocklistParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
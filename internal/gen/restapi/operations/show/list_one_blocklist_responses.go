@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package show
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+// ListOneBlocklistOKCode is the HTTP code returned for type ListOneBlocklistOK
+const ListOneBlocklistOKCode int = 200
+
+/*
+ListOneBlocklistOK OK
+
+swagger:response listOneBlocklistOK
+*/
+type ListOneBlocklistOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Blocklist `json:"body,omitempty"`
+}
+
+// NewListOneBlocklistOK creates ListOneBlocklistOK with default headers values
+func NewListOneBlocklistOK() *ListOneBlocklistOK {
+
+	return &ListOneBlocklistOK{}
+}
+
+// WithPayload adds the payload to the list one blocklist o k response
+func (o *ListOneBlocklistOK) WithPayload(payload *models.Blocklist) *ListOneBlocklistOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list one blocklist o k response
+func (o *ListOneBlocklistOK) SetPayload(payload *models.Blocklist) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListOneBlocklistOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListOneBlocklistBadRequestCode is the HTTP code returned for type ListOneBlocklistBadRequest
+const ListOneBlocklistBadRequestCode int = 400
+
+/*
+ListOneBlocklistBadRequest Bad request
+
+swagger:response listOneBlocklistBadRequest
+*/
+type ListOneBlocklistBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewListOneBlocklistBadRequest creates ListOneBlocklistBadRequest with default headers values
+func NewListOneBlocklistBadRequest() *ListOneBlocklistBadRequest {
+
+	return &ListOneBlocklistBadRequest{}
+}
+
+// WithPayload adds the payload to the list one blocklist bad request response
+func (o *ListOneBlocklistBadRequest) WithPayload(payload *models.Answer) *ListOneBlocklistBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list one blocklist bad request response
+func (o *ListOneBlocklistBadRequest) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListOneBlocklistBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
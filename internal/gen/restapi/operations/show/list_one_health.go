@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package show
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ListOneHealthHandlerFunc turns a function with the right signature into a list one health handler
+type ListOneHealthHandlerFunc func(ListOneHealthParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListOneHealthHandlerFunc) Handle(params ListOneHealthParams) middleware.Responder {
+	return fn(params)
+}
+
+// ListOneHealthHandler interface for that can handle valid list one health params
+type ListOneHealthHandler interface {
+	Handle(ListOneHealthParams) middleware.Responder
+}
+
+// NewListOneHealth creates a new http.Handler for the list one health operation
+func NewListOneHealth(ctx *middleware.Context, handler ListOneHealthHandler) *ListOneHealth {
+	return &ListOneHealth{Context: ctx, Handler: handler}
+}
+
+/*
+	ListOneHealth swagger:route GET /health/{domain} show listOneHealth
+
+List status of health checks of one dns entry
+*/
+type ListOneHealth struct {
+	Context *middleware.Context
+	Handler ListOneHealthHandler
+}
+
+func (o *ListOneHealth) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListOneHealthParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
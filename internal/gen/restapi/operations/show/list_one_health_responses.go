@@ -0,0 +1,107 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package show
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+// ListOneHealthOKCode is the HTTP code returned for type ListOneHealthOK
+const ListOneHealthOKCode int = 200
+
+/*
+ListOneHealthOK OK
+
+swagger:response listOneHealthOK
+*/
+type ListOneHealthOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.HealthStatusList `json:"body,omitempty"`
+}
+
+// NewListOneHealthOK creates ListOneHealthOK with default headers values
+func NewListOneHealthOK() *ListOneHealthOK {
+
+	return &ListOneHealthOK{}
+}
+
+// WithPayload adds the payload to the list one health o k response
+func (o *ListOneHealthOK) WithPayload(payload models.HealthStatusList) *ListOneHealthOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list one health o k response
+func (o *ListOneHealthOK) SetPayload(payload models.HealthStatusList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListOneHealthOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.HealthStatusList{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// ListOneHealthBadRequestCode is the HTTP code returned for type ListOneHealthBadRequest
+const ListOneHealthBadRequestCode int = 400
+
+/*
+ListOneHealthBadRequest Bad request
+
+swagger:response listOneHealthBadRequest
+*/
+type ListOneHealthBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewListOneHealthBadRequest creates ListOneHealthBadRequest with default headers values
+func NewListOneHealthBadRequest() *ListOneHealthBadRequest {
+
+	return &ListOneHealthBadRequest{}
+}
+
+// WithPayload adds the payload to the list one health bad request response
+func (o *ListOneHealthBadRequest) WithPayload(payload *models.Answer) *ListOneHealthBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list one health bad request response
+func (o *ListOneHealthBadRequest) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListOneHealthBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
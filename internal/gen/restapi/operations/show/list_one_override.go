@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package show
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ListOneOverrideHandlerFunc turns a function with the right signature into a list one override handler
+type ListOneOverrideHandlerFunc func(ListOneOverrideParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListOneOverrideHandlerFunc) Handle(params ListOneOverrideParams) middleware.Responder {
+	return fn(params)
+}
+
+// ListOneOverrideHandler interface for that can handle valid list one override params
+type ListOneOverrideHandler interface {
+	Handle(ListOneOverrideParams) middleware.Responder
+}
+
+// NewListOneOverride creates a new http.Handler for the list one override operation
+func NewListOneOverride(ctx *middleware.Context, handler ListOneOverrideHandler) *ListOneOverride {
+	return &ListOneOverride{Context: ctx, Handler: handler}
+}
+
+/*
+	ListOneOverride swagger:route GET /override/{name} show listOneOverride
+
+List one static override
+*/
+type ListOneOverride struct {
+	Context *middleware.Context
+	Handler ListOneOverrideHandler
+}
+
+func (o *ListOneOverride) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListOneOverrideParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package show
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+// ListOneOverrideOKCode is the HTTP code returned for type ListOneOverrideOK
+const ListOneOverrideOKCode int = 200
+
+/*
+ListOneOverrideOK OK
+
+swagger:response listOneOverrideOK
+*/
+type ListOneOverrideOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Override `json:"body,omitempty"`
+}
+
+// NewListOneOverrideOK creates ListOneOverrideOK with default headers values
+func NewListOneOverrideOK() *ListOneOverrideOK {
+
+	return &ListOneOverrideOK{}
+}
+
+// WithPayload adds the payload to the list one override o k response
+func (o *ListOneOverrideOK) WithPayload(payload *models.Override) *ListOneOverrideOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list one override o k response
+func (o *ListOneOverrideOK) SetPayload(payload *models.Override) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListOneOverrideOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListOneOverrideBadRequestCode is the HTTP code returned for type ListOneOverrideBadRequest
+const ListOneOverrideBadRequestCode int = 400
+
+/*
+ListOneOverrideBadRequest Bad request
+
+swagger:response listOneOverrideBadRequest
+*/
+type ListOneOverrideBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewListOneOverrideBadRequest creates ListOneOverrideBadRequest with default headers values
+func NewListOneOverrideBadRequest() *ListOneOverrideBadRequest {
+
+	return &ListOneOverrideBadRequest{}
+}
+
+// WithPayload adds the payload to the list one override bad request response
+func (o *ListOneOverrideBadRequest) WithPayload(payload *models.Answer) *ListOneOverrideBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list one override bad request response
+func (o *ListOneOverrideBadRequest) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListOneOverrideBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package show
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ListOneViewHandlerFunc turns a function with the right signature into a list one view handler
+type ListOneViewHandlerFunc func(ListOneViewParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListOneViewHandlerFunc) Handle(params ListOneViewParams) middleware.Responder {
+	return fn(params)
+}
+
+// ListOneViewHandler interface for that can handle valid list one view params
+type ListOneViewHandler interface {
+	Handle(ListOneViewParams) middleware.Responder
+}
+
+// NewListOneView creates a new http.Handler for the list one view operation
+func NewListOneView(ctx *middleware.Context, handler ListOneViewHandler) *ListOneView {
+	return &ListOneView{Context: ctx, Handler: handler}
+}
+
+/*
+	ListOneView swagger:route GET /views/{view} show listOneView
+
+List one view
+*/
+type ListOneView struct {
+	Context *middleware.Context
+	Handler ListOneViewHandler
+}
+
+func (o *ListOneView) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListOneViewParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
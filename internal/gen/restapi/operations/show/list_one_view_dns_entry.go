@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package show
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ListOneViewDNSEntryHandlerFunc turns a function with the right signature into a list one view dns entry handler
+type ListOneViewDNSEntryHandlerFunc func(ListOneViewDNSEntryParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListOneViewDNSEntryHandlerFunc) Handle(params ListOneViewDNSEntryParams) middleware.Responder {
+	return fn(params)
+}
+
+// ListOneViewDNSEntryHandler interface for that can handle valid list one view dns entry params
+type ListOneViewDNSEntryHandler interface {
+	Handle(ListOneViewDNSEntryParams) middleware.Responder
+}
+
+// NewListOneViewDNSEntry creates a new http.Handler for the list one view dns entry operation
+func NewListOneViewDNSEntry(ctx *middleware.Context, handler ListOneViewDNSEntryHandler) *ListOneViewDNSEntry {
+	return &ListOneViewDNSEntry{Context: ctx, Handler: handler}
+}
+
+/*
+	ListOneViewDNSEntry swagger:route GET /views/{view}/dns/{domain} show listOneViewDnsEntry
+
+List one dns entry of view
+*/
+type ListOneViewDNSEntry struct {
+	Context *middleware.Context
+	Handler ListOneViewDNSEntryHandler
+}
+
+func (o *ListOneViewDNSEntry) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListOneViewDNSEntryParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
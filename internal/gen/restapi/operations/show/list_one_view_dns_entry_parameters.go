@@ -0,0 +1,95 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package show
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListOneViewDNSEntryParams creates a new ListOneViewDNSEntryParams object
+//
+// There are no default values defined in the spec.
+func NewListOneViewDNSEntryParams() ListOneViewDNSEntryParams {
+
+	return ListOneViewDNSEntryParams{}
+}
+
+// ListOneViewDNSEntryParams contains all the bound params for the list one view dns entry operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters list_one_view_dns_entry
+type ListOneViewDNSEntryParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  Required: true
+	  In: path
+	*/
+	Domain string
+	/*
+	  Required: true
+	  In: path
+	*/
+	View string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewListOneViewDNSEntryParams() beforehand.
+func (o *ListOneViewDNSEntryParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rDomain, rhkDomain, _ := route.Params.GetOK("domain")
+	if err := o.bindDomain(rDomain, rhkDomain, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rView, rhkView, _ := route.Params.GetOK("view")
+	if err := o.bindView(rView, rhkView, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindDomain binds and validates parameter Domain from path.
+func (o *ListOneViewDNSEntryParams) bindDomain(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.Domain = raw
+
+	return nil
+}
+
+// bindView binds and validates parameter View from path.
+func (o *ListOneViewDNSEntryParams) bindView(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.View = raw
+
+	return nil
+}
@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package show
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+// ListOneViewDNSEntryOKCode is the HTTP code returned for type ListOneViewDNSEntryOK
+const ListOneViewDNSEntryOKCode int = 200
+
+/*
+ListOneViewDNSEntryOK OK
+
+swagger:response listOneViewDnsEntryOK
+*/
+type ListOneViewDNSEntryOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.DNSEntry `json:"body,omitempty"`
+}
+
+// NewListOneViewDNSEntryOK creates ListOneViewDNSEntryOK with default headers values
+func NewListOneViewDNSEntryOK() *ListOneViewDNSEntryOK {
+
+	return &ListOneViewDNSEntryOK{}
+}
+
+// WithPayload adds the payload to the list one view Dns entry o k response
+func (o *ListOneViewDNSEntryOK) WithPayload(payload *models.DNSEntry) *ListOneViewDNSEntryOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list one view Dns entry o k response
+func (o *ListOneViewDNSEntryOK) SetPayload(payload *models.DNSEntry) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListOneViewDNSEntryOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListOneViewDNSEntryBadRequestCode is the HTTP code returned for type ListOneViewDNSEntryBadRequest
+const ListOneViewDNSEntryBadRequestCode int = 400
+
+/*
+ListOneViewDNSEntryBadRequest Bad request
+
+swagger:response listOneViewDnsEntryBadRequest
+*/
+type ListOneViewDNSEntryBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewListOneViewDNSEntryBadRequest creates ListOneViewDNSEntryBadRequest with default headers values
+func NewListOneViewDNSEntryBadRequest() *ListOneViewDNSEntryBadRequest {
+
+	return &ListOneViewDNSEntryBadRequest{}
+}
+
+// WithPayload adds the payload to the list one view Dns entry bad request response
+func (o *ListOneViewDNSEntryBadRequest) WithPayload(payload *models.Answer) *ListOneViewDNSEntryBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list one view Dns entry bad request response
+func (o *ListOneViewDNSEntryBadRequest) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListOneViewDNSEntryBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package show
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+// ListOneViewOKCode is the HTTP code returned for type ListOneViewOK
+const ListOneViewOKCode int = 200
+
+/*
+ListOneViewOK OK
+
+swagger:response listOneViewOK
+*/
+type ListOneViewOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.View `json:"body,omitempty"`
+}
+
+// NewListOneViewOK creates ListOneViewOK with default headers values
+func NewListOneViewOK() *ListOneViewOK {
+
+	return &ListOneViewOK{}
+}
+
+// WithPayload adds the payload to the list one view o k response
+func (o *ListOneViewOK) WithPayload(payload *models.View) *ListOneViewOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list one view o k response
+func (o *ListOneViewOK) SetPayload(payload *models.View) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListOneViewOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListOneViewBadRequestCode is the HTTP code returned for type ListOneViewBadRequest
+const ListOneViewBadRequestCode int = 400
+
+/*
+ListOneViewBadRequest Bad request
+
+swagger:response listOneViewBadRequest
+*/
+type ListOneViewBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewListOneViewBadRequest creates ListOneViewBadRequest with default headers values
+func NewListOneViewBadRequest() *ListOneViewBadRequest {
+
+	return &ListOneViewBadRequest{}
+}
+
+// WithPayload adds the payload to the list one view bad request response
+func (o *ListOneViewBadRequest) WithPayload(payload *models.Answer) *ListOneViewBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list one view bad request response
+func (o *ListOneViewBadRequest) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListOneViewBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
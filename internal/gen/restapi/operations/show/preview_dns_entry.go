@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package show
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// PreviewDNSEntryHandlerFunc turns a function with the right signature into a preview dns entry handler
+type PreviewDNSEntryHandlerFunc func(PreviewDNSEntryParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PreviewDNSEntryHandlerFunc) Handle(params PreviewDNSEntryParams) middleware.Responder {
+	return fn(params)
+}
+
+// PreviewDNSEntryHandler interface for that can handle valid preview dns entry params
+type PreviewDNSEntryHandler interface {
+	Handle(PreviewDNSEntryParams) middleware.Responder
+}
+
+// NewPreviewDNSEntry creates a new http.Handler for the preview dns entry operation
+func NewPreviewDNSEntry(ctx *middleware.Context, handler PreviewDNSEntryHandler) *PreviewDNSEntry {
+	return &PreviewDNSEntry{Context: ctx, Handler: handler}
+}
+
+/*
+	PreviewDNSEntry swagger:route GET /dns/{domain}/preview show previewDnsEntry
+
+Preview addresses a client would receive for dns entry
+*/
+type PreviewDNSEntry struct {
+	Context *middleware.Context
+	Handler PreviewDNSEntryHandler
+}
+
+func (o *PreviewDNSEntry) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPreviewDNSEntryParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,106 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package show
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NewPreviewDNSEntryParams creates a new PreviewDNSEntryParams object
+//
+// There are no default values defined in the spec.
+func NewPreviewDNSEntryParams() PreviewDNSEntryParams {
+
+	return PreviewDNSEntryParams{}
+}
+
+// PreviewDNSEntryParams contains all the bound params for the preview dns entry operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters preview_dns_entry
+type PreviewDNSEntryParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  Required: true
+	  In: path
+	*/
+	Domain string
+	/*address or network in CIDR notation of client
+	  Required: true
+	  In: query
+	*/
+	IP string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewPreviewDNSEntryParams() beforehand.
+func (o *PreviewDNSEntryParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	rDomain, rhkDomain, _ := route.Params.GetOK("domain")
+	if err := o.bindDomain(rDomain, rhkDomain, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qIP, qhkIP, _ := qs.GetOK("ip")
+	if err := o.bindIP(qIP, qhkIP, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindDomain binds and validates parameter Domain from path.
+func (o *PreviewDNSEntryParams) bindDomain(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.Domain = raw
+
+	return nil
+}
+
+// bindIP binds and validates parameter IP from query.
+func (o *PreviewDNSEntryParams) bindIP(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	if !hasKey {
+		return errors.Required("ip", "query", rawData)
+	}
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// AllowEmptyValue: false
+
+	if err := validate.RequiredString("ip", "query", raw); err != nil {
+		return err
+	}
+	o.IP = raw
+
+	return nil
+}
@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package show
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+// PreviewDNSEntryOKCode is the HTTP code returned for type PreviewDNSEntryOK
+const PreviewDNSEntryOKCode int = 200
+
+/*
+PreviewDNSEntryOK OK
+
+swagger:response previewDnsEntryOK
+*/
+type PreviewDNSEntryOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Preview `json:"body,omitempty"`
+}
+
+// NewPreviewDNSEntryOK creates PreviewDNSEntryOK with default headers values
+func NewPreviewDNSEntryOK() *PreviewDNSEntryOK {
+
+	return &PreviewDNSEntryOK{}
+}
+
+// WithPayload adds the payload to the preview Dns entry o k response
+func (o *PreviewDNSEntryOK) WithPayload(payload *models.Preview) *PreviewDNSEntryOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the preview Dns entry o k response
+func (o *PreviewDNSEntryOK) SetPayload(payload *models.Preview) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PreviewDNSEntryOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PreviewDNSEntryBadRequestCode is the HTTP code returned for type PreviewDNSEntryBadRequest
+const PreviewDNSEntryBadRequestCode int = 400
+
+/*
+PreviewDNSEntryBadRequest Bad request
+
+swagger:response previewDnsEntryBadRequest
+*/
+type PreviewDNSEntryBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewPreviewDNSEntryBadRequest creates PreviewDNSEntryBadRequest with default headers values
+func NewPreviewDNSEntryBadRequest() *PreviewDNSEntryBadRequest {
+
+	return &PreviewDNSEntryBadRequest{}
+}
+
+// WithPayload adds the payload to the preview Dns entry bad request response
+func (o *PreviewDNSEntryBadRequest) WithPayload(payload *models.Answer) *PreviewDNSEntryBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the preview Dns entry bad request response
+func (o *PreviewDNSEntryBadRequest) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PreviewDNSEntryBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
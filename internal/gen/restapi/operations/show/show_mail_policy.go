@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package show
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ShowMailPolicyHandlerFunc turns a function with the right signature into a show mail policy handler
+type ShowMailPolicyHandlerFunc func(ShowMailPolicyParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ShowMailPolicyHandlerFunc) Handle(params ShowMailPolicyParams) middleware.Responder {
+	return fn(params)
+}
+
+// ShowMailPolicyHandler interface for that can handle valid show mail policy params
+type ShowMailPolicyHandler interface {
+	Handle(ShowMailPolicyParams) middleware.Responder
+}
+
+// NewShowMailPolicy creates a new http.Handler for the show mail policy operation
+func NewShowMailPolicy(ctx *middleware.Context, handler ShowMailPolicyHandler) *ShowMailPolicy {
+	return &ShowMailPolicy{Context: ctx, Handler: handler}
+}
+
+/*
+	ShowMailPolicy swagger:route GET /dns/{domain}/mail show showMailPolicy
+
+Show mail policy of dns entry
+*/
+type ShowMailPolicy struct {
+	Context *middleware.Context
+	Handler ShowMailPolicyHandler
+}
+
+func (o *ShowMailPolicy) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewShowMailPolicyParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
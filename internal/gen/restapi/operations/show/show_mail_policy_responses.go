@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package show
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+// ShowMailPolicyOKCode is the HTTP code returned for type ShowMailPolicyOK
+const ShowMailPolicyOKCode int = 200
+
+/*
+ShowMailPolicyOK OK
+
+swagger:response showMailPolicyOK
+*/
+type ShowMailPolicyOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.MailPolicy `json:"body,omitempty"`
+}
+
+// NewShowMailPolicyOK creates ShowMailPolicyOK with default headers values
+func NewShowMailPolicyOK() *ShowMailPolicyOK {
+
+	return &ShowMailPolicyOK{}
+}
+
+// WithPayload adds the payload to the show mail policy o k response
+func (o *ShowMailPolicyOK) WithPayload(payload *models.MailPolicy) *ShowMailPolicyOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the show mail policy o k response
+func (o *ShowMailPolicyOK) SetPayload(payload *models.MailPolicy) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ShowMailPolicyOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ShowMailPolicyBadRequestCode is the HTTP code returned for type ShowMailPolicyBadRequest
+const ShowMailPolicyBadRequestCode int = 400
+
+/*
+ShowMailPolicyBadRequest Bad request
+
+swagger:response showMailPolicyBadRequest
+*/
+type ShowMailPolicyBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewShowMailPolicyBadRequest creates ShowMailPolicyBadRequest with default headers values
+func NewShowMailPolicyBadRequest() *ShowMailPolicyBadRequest {
+
+	return &ShowMailPolicyBadRequest{}
+}
+
+// WithPayload adds the payload to the show mail policy bad request response
+func (o *ShowMailPolicyBadRequest) WithPayload(payload *models.Answer) *ShowMailPolicyBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the show mail policy bad request response
+func (o *ShowMailPolicyBadRequest) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ShowMailPolicyBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package show
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ShowRrlHandlerFunc turns a function with the right signature into a show rrl handler
+type ShowRrlHandlerFunc func(ShowRrlParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ShowRrlHandlerFunc) Handle(params ShowRrlParams) middleware.Responder {
+	return fn(params)
+}
+
+// ShowRrlHandler interface for that can handle valid show rrl params
+type ShowRrlHandler interface {
+	Handle(ShowRrlParams) middleware.Responder
+}
+
+// NewShowRrl creates a new http.Handler for the show rrl operation
+func NewShowRrl(ctx *middleware.Context, handler ShowRrlHandler) *ShowRrl {
+	return &ShowRrl{Context: ctx, Handler: handler}
+}
+
+/*
+	ShowRrl swagger:route GET /rrl show showRrl
+
+Show settings and counters of response rate limiting
+*/
+type ShowRrl struct {
+	Context *middleware.Context
+	Handler ShowRrlHandler
+}
+
+func (o *ShowRrl) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewShowRrlParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
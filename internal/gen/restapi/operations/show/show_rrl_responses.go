@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package show
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+// ShowRrlOKCode is the HTTP code returned for type ShowRrlOK
+const ShowRrlOKCode int = 200
+
+/*
+ShowRrlOK OK
+
+swagger:response showRrlOK
+*/
+type ShowRrlOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Rrl `json:"body,omitempty"`
+}
+
+// NewShowRrlOK creates ShowRrlOK with default headers values
+func NewShowRrlOK() *ShowRrlOK {
+
+	return &ShowRrlOK{}
+}
+
+// WithPayload adds the payload to the show rrl o k response
+func (o *ShowRrlOK) WithPayload(payload *models.Rrl) *ShowRrlOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the show rrl o k response
+func (o *ShowRrlOK) SetPayload(payload *models.Rrl) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ShowRrlOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ShowRrlBadRequestCode is the HTTP code returned for type ShowRrlBadRequest
+const ShowRrlBadRequestCode int = 400
+
+/*
+ShowRrlBadRequest Bad request
+
+swagger:response showRrlBadRequest
+*/
+type ShowRrlBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewShowRrlBadRequest creates ShowRrlBadRequest with default headers values
+func NewShowRrlBadRequest() *ShowRrlBadRequest {
+
+	return &ShowRrlBadRequest{}
+}
+
+// WithPayload adds the payload to the show rrl bad request response
+func (o *ShowRrlBadRequest) WithPayload(payload *models.Answer) *ShowRrlBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the show rrl bad request response
+func (o *ShowRrlBadRequest) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ShowRrlBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
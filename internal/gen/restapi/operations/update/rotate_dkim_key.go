@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package update
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// RotateDkimKeyHandlerFunc turns a function with the right signature into a rotate dkim key handler
+type RotateDkimKeyHandlerFunc func(RotateDkimKeyParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn RotateDkimKeyHandlerFunc) Handle(params RotateDkimKeyParams) middleware.Responder {
+	return fn(params)
+}
+
+// RotateDkimKeyHandler interface for that can handle valid rotate dkim key params
+type RotateDkimKeyHandler interface {
+	Handle(RotateDkimKeyParams) middleware.Responder
+}
+
+// NewRotateDkimKey creates a new http.Handler for the rotate dkim key operation
+func NewRotateDkimKey(ctx *middleware.Context, handler RotateDkimKeyHandler) *RotateDkimKey {
+	return &RotateDkimKey{Context: ctx, Handler: handler}
+}
+
+/*
+	RotateDkimKey swagger:route PUT /dns/{domain}/dkim update rotateDkimKey
+
+Rotate DKIM key, new selector is published first and signs after publish delay
+*/
+type RotateDkimKey struct {
+	Context *middleware.Context
+	Handler RotateDkimKeyHandler
+}
+
+func (o *RotateDkimKey) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewRotateDkimKeyParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
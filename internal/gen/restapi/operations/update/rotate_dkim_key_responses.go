@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package update
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+// RotateDkimKeyOKCode is the HTTP code returned for type RotateDkimKeyOK
+const RotateDkimKeyOKCode int = 200
+
+/*
+RotateDkimKeyOK OK
+
+swagger:response rotateDkimKeyOK
+*/
+type RotateDkimKeyOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.DkimKey `json:"body,omitempty"`
+}
+
+// NewRotateDkimKeyOK creates RotateDkimKeyOK with default headers values
+func NewRotateDkimKeyOK() *RotateDkimKeyOK {
+
+	return &RotateDkimKeyOK{}
+}
+
+// WithPayload adds the payload to the rotate dkim key o k response
+func (o *RotateDkimKeyOK) WithPayload(payload *models.DkimKey) *RotateDkimKeyOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the rotate dkim key o k response
+func (o *RotateDkimKeyOK) SetPayload(payload *models.DkimKey) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RotateDkimKeyOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RotateDkimKeyBadRequestCode is the HTTP code returned for type RotateDkimKeyBadRequest
+const RotateDkimKeyBadRequestCode int = 400
+
+/*
+RotateDkimKeyBadRequest Bad request
+
+swagger:response rotateDkimKeyBadRequest
+*/
+type RotateDkimKeyBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewRotateDkimKeyBadRequest creates RotateDkimKeyBadRequest with default headers values
+func NewRotateDkimKeyBadRequest() *RotateDkimKeyBadRequest {
+
+	return &RotateDkimKeyBadRequest{}
+}
+
+// WithPayload adds the payload to the rotate dkim key bad request response
+func (o *RotateDkimKeyBadRequest) WithPayload(payload *models.Answer) *RotateDkimKeyBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the rotate dkim key bad request response
+func (o *RotateDkimKeyBadRequest) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RotateDkimKeyBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
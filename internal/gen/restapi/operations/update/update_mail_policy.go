@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package update
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// UpdateMailPolicyHandlerFunc turns a function with the right signature into a update mail policy handler
+type UpdateMailPolicyHandlerFunc func(UpdateMailPolicyParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn UpdateMailPolicyHandlerFunc) Handle(params UpdateMailPolicyParams) middleware.Responder {
+	return fn(params)
+}
+
+// UpdateMailPolicyHandler interface for that can handle valid update mail policy params
+type UpdateMailPolicyHandler interface {
+	Handle(UpdateMailPolicyParams) middleware.Responder
+}
+
+// NewUpdateMailPolicy creates a new http.Handler for the update mail policy operation
+func NewUpdateMailPolicy(ctx *middleware.Context, handler UpdateMailPolicyHandler) *UpdateMailPolicy {
+	return &UpdateMailPolicy{Context: ctx, Handler: handler}
+}
+
+/*
+	UpdateMailPolicy swagger:route PUT /dns/{domain}/mail update updateMailPolicy
+
+Update mail policy of dns entry, SPF and DMARC records are generated from it
+*/
+type UpdateMailPolicy struct {
+	Context *middleware.Context
+	Handler UpdateMailPolicyHandler
+}
+
+func (o *UpdateMailPolicy) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewUpdateMailPolicyParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package update
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+// UpdateMailPolicyOKCode is the HTTP code returned for type UpdateMailPolicyOK
+const UpdateMailPolicyOKCode int = 200
+
+/*
+UpdateMailPolicyOK OK
+
+swagger:response updateMailPolicyOK
+*/
+type UpdateMailPolicyOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.MailPolicy `json:"body,omitempty"`
+}
+
+// NewUpdateMailPolicyOK creates UpdateMailPolicyOK with default headers values
+func NewUpdateMailPolicyOK() *UpdateMailPolicyOK {
+
+	return &UpdateMailPolicyOK{}
+}
+
+// WithPayload adds the payload to the update mail policy o k response
+func (o *UpdateMailPolicyOK) WithPayload(payload *models.MailPolicy) *UpdateMailPolicyOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update mail policy o k response
+func (o *UpdateMailPolicyOK) SetPayload(payload *models.MailPolicy) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateMailPolicyOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateMailPolicyBadRequestCode is the HTTP code returned for type UpdateMailPolicyBadRequest
+const UpdateMailPolicyBadRequestCode int = 400
+
+/*
+UpdateMailPolicyBadRequest Bad request
+
+swagger:response updateMailPolicyBadRequest
+*/
+type UpdateMailPolicyBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewUpdateMailPolicyBadRequest creates UpdateMailPolicyBadRequest with default headers values
+func NewUpdateMailPolicyBadRequest() *UpdateMailPolicyBadRequest {
+
+	return &UpdateMailPolicyBadRequest{}
+}
+
+// WithPayload adds the payload to the update mail policy bad request response
+func (o *UpdateMailPolicyBadRequest) WithPayload(payload *models.Answer) *UpdateMailPolicyBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update mail policy bad request response
+func (o *UpdateMailPolicyBadRequest) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateMailPolicyBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
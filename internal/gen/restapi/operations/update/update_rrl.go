@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package update
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// UpdateRrlHandlerFunc turns a function with the right signature into a update rrl handler
+type UpdateRrlHandlerFunc func(UpdateRrlParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn UpdateRrlHandlerFunc) Handle(params UpdateRrlParams) middleware.Responder {
+	return fn(params)
+}
+
+// UpdateRrlHandler interface for that can handle valid update rrl params
+type UpdateRrlHandler interface {
+	Handle(UpdateRrlParams) middleware.Responder
+}
+
+// NewUpdateRrl creates a new http.Handler for the update rrl operation
+func NewUpdateRrl(ctx *middleware.Context, handler UpdateRrlHandler) *UpdateRrl {
+	return &UpdateRrl{Context: ctx, Handler: handler}
+}
+
+/*
+	UpdateRrl swagger:route PUT /rrl update updateRrl
+
+Update settings of response rate limiting, omitted settings are kept
+*/
+type UpdateRrl struct {
+	Context *middleware.Context
+	Handler UpdateRrlHandler
+}
+
+func (o *UpdateRrl) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewUpdateRrlParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package update
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+// UpdateRrlOKCode is the HTTP code returned for type UpdateRrlOK
+const UpdateRrlOKCode int = 200
+
+/*
+UpdateRrlOK OK
+
+swagger:response updateRrlOK
+*/
+type UpdateRrlOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Rrl `json:"body,omitempty"`
+}
+
+// NewUpdateRrlOK creates UpdateRrlOK with default headers values
+func NewUpdateRrlOK() *UpdateRrlOK {
+
+	return &UpdateRrlOK{}
+}
+
+// WithPayload adds the payload to the update rrl o k response
+func (o *UpdateRrlOK) WithPayload(payload *models.Rrl) *UpdateRrlOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update rrl o k response
+func (o *UpdateRrlOK) SetPayload(payload *models.Rrl) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateRrlOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateRrlBadRequestCode is the HTTP code returned for type UpdateRrlBadRequest
+const UpdateRrlBadRequestCode int = 400
+
+/*
+UpdateRrlBadRequest Bad request
+
+swagger:response updateRrlBadRequest
+*/
+type UpdateRrlBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewUpdateRrlBadRequest creates UpdateRrlBadRequest with default headers values
+func NewUpdateRrlBadRequest() *UpdateRrlBadRequest {
+
+	return &UpdateRrlBadRequest{}
+}
+
+// WithPayload adds the payload to the update rrl bad request response
+func (o *UpdateRrlBadRequest) WithPayload(payload *models.Answer) *UpdateRrlBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update rrl bad request response
+func (o *UpdateRrlBadRequest) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateRrlBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package update
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// UpdateViewDNSEntryHandlerFunc turns a function with the right signature into a update view dns entry handler
+type UpdateViewDNSEntryHandlerFunc func(UpdateViewDNSEntryParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn UpdateViewDNSEntryHandlerFunc) Handle(params UpdateViewDNSEntryParams) middleware.Responder {
+	return fn(params)
+}
+
+// UpdateViewDNSEntryHandler interface for that can handle valid update view dns entry params
+type UpdateViewDNSEntryHandler interface {
+	Handle(UpdateViewDNSEntryParams) middleware.Responder
+}
+
+// NewUpdateViewDNSEntry creates a new http.Handler for the update view dns entry operation
+func NewUpdateViewDNSEntry(ctx *middleware.Context, handler UpdateViewDNSEntryHandler) *UpdateViewDNSEntry {
+	return &UpdateViewDNSEntry{Context: ctx, Handler: handler}
+}
+
+/*
+	UpdateViewDNSEntry swagger:route PUT /views/{view}/dns update updateViewDnsEntry
+
+Update dns entry of view
+*/
+type UpdateViewDNSEntry struct {
+	Context *middleware.Context
+	Handler UpdateViewDNSEntryHandler
+}
+
+func (o *UpdateViewDNSEntry) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewUpdateViewDNSEntryParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package update
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/MarlikAlmighty/mdns/internal/gen/models"
+)
+
+// UpdateViewDNSEntryOKCode is the HTTP code returned for type UpdateViewDNSEntryOK
+const UpdateViewDNSEntryOKCode int = 200
+
+/*
+UpdateViewDNSEntryOK OK
+
+swagger:response updateViewDnsEntryOK
+*/
+type UpdateViewDNSEntryOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.DNSEntry `json:"body,omitempty"`
+}
+
+// NewUpdateViewDNSEntryOK creates UpdateViewDNSEntryOK with default headers values
+func NewUpdateViewDNSEntryOK() *UpdateViewDNSEntryOK {
+
+	return &UpdateViewDNSEntryOK{}
+}
+
+// WithPayload adds the payload to the update view Dns entry o k response
+func (o *UpdateViewDNSEntryOK) WithPayload(payload *models.DNSEntry) *UpdateViewDNSEntryOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update view Dns entry o k response
+func (o *UpdateViewDNSEntryOK) SetPayload(payload *models.DNSEntry) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateViewDNSEntryOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateViewDNSEntryBadRequestCode is the HTTP code returned for type UpdateViewDNSEntryBadRequest
+const UpdateViewDNSEntryBadRequestCode int = 400
+
+/*
+UpdateViewDNSEntryBadRequest Bad request
+
+swagger:response updateViewDnsEntryBadRequest
+*/
+type UpdateViewDNSEntryBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Answer `json:"body,omitempty"`
+}
+
+// NewUpdateViewDNSEntryBadRequest creates UpdateViewDNSEntryBadRequest with default headers values
+func NewUpdateViewDNSEntryBadRequest() *UpdateViewDNSEntryBadRequest {
+
+	return &UpdateViewDNSEntryBadRequest{}
+}
+
+// WithPayload adds the payload to the update view Dns entry bad request response
+func (o *UpdateViewDNSEntryBadRequest) WithPayload(payload *models.Answer) *UpdateViewDNSEntryBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update view Dns entry bad request response
+func (o *UpdateViewDNSEntryBadRequest) SetPayload(payload *models.Answer) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateViewDNSEntryBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}